@@ -0,0 +1,11 @@
+data "pingone_authorize_api_service_operation" "find_by_id_example" {
+  environment_id           = var.environment_id
+  api_service_id           = var.api_service_id
+  api_service_operation_id = var.api_service_operation_id
+}
+
+data "pingone_authorize_api_service_operation" "find_by_name_example" {
+  environment_id = var.environment_id
+  api_service_id = var.api_service_id
+  name           = "My API Service Operation"
+}
@@ -0,0 +1,4 @@
+data "pingone_authorize_api_service_operations" "example_all_api_service_operation_ids" {
+  environment_id = var.environment_id
+  api_service_id = var.api_service_id
+}
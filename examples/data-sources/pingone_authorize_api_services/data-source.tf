@@ -0,0 +1,3 @@
+data "pingone_authorize_api_services" "example_all_api_service_ids" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,9 @@
+data "pingone_authorize_application_role" "find_by_id_example" {
+  environment_id      = var.environment_id
+  application_role_id = var.application_role_id
+}
+
+data "pingone_authorize_application_role" "find_by_name_example" {
+  environment_id = var.environment_id
+  name           = "My Application Role"
+}
@@ -0,0 +1,3 @@
+data "pingone_authorize_application_roles" "example_all_application_role_ids" {
+  environment_id = var.environment_id
+}
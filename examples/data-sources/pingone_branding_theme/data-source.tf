@@ -0,0 +1,14 @@
+data "pingone_branding_theme" "find_by_id_example" {
+  environment_id    = var.environment_id
+  branding_theme_id = var.branding_theme_id
+}
+
+data "pingone_branding_theme" "find_by_name_example" {
+  environment_id = var.environment_id
+  name           = "foo"
+}
+
+data "pingone_branding_theme" "find_default_theme_example" {
+  environment_id = var.environment_id
+  default        = true
+}
@@ -0,0 +1,3 @@
+data "pingone_branding_themes" "example_all_branding_theme_ids" {
+  environment_id = var.environment_id
+}
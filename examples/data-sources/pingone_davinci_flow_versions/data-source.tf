@@ -0,0 +1,11 @@
+data "pingone_davinci_flow_versions" "example" {
+  environment_id = var.environment_id
+  flow_id        = var.flow_id
+}
+
+# Roll back to the version before the most recent version
+resource "pingone_davinci_flow_deploy" "rollback" {
+  environment_id = var.environment_id
+  flow_id        = var.flow_id
+  version        = data.pingone_davinci_flow_versions.example.versions[length(data.pingone_davinci_flow_versions.example.versions) - 2].version
+}
\ No newline at end of file
@@ -1,3 +1,8 @@
 data "pingone_environments" "by_scim_filter" {
   scim_filter = "(name sw \"TEST-\") and (license.id eq \"${var.license_id}\")"
 }
+
+data "pingone_environments" "with_details" {
+  scim_filter     = "(name sw \"TEST-\") and (license.id eq \"${var.license_id}\")"
+  include_details = true
+}
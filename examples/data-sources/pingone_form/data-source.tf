@@ -0,0 +1,9 @@
+data "pingone_form" "find_by_id_example" {
+  environment_id = var.environment_id
+  form_id        = var.form_id
+}
+
+data "pingone_form" "find_by_name_example" {
+  environment_id = var.environment_id
+  name           = "My Form"
+}
@@ -0,0 +1,3 @@
+data "pingone_forms" "example_all_forms" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,3 @@
+data "pingone_forms_recaptcha_v2" "example" {
+  environment_id = var.environment_id
+}
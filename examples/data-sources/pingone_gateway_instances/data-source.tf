@@ -0,0 +1,28 @@
+data "pingone_gateway_instances" "my_ldap_gateway" {
+  environment_id = var.environment_id
+  gateway_id     = var.ldap_gateway_id
+}
+
+resource "pingone_gateway" "my_ldap_gateway" {
+  environment_id = var.environment_id
+  name           = "My LDAP Gateway"
+  enabled        = true
+  type           = "LDAP"
+
+  # ...
+
+  user_types = {
+    "Default" = {
+      password_authority = "LDAP"
+
+      # ...
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.pingone_gateway_instances.my_ldap_gateway.healthy_instance_count > 0
+      error_message = "At least one connected and healthy gateway instance is required before LDAP can be the password authority."
+    }
+  }
+}
\ No newline at end of file
@@ -14,3 +14,10 @@ data "pingone_groups" "by_data_filter" {
     }
   ]
 }
+
+data "pingone_groups" "with_details" {
+  environment_id = var.environment_id
+
+  scim_filter     = "(name eq \"My first group\") OR (name eq \"My second group\")"
+  include_details = true
+}
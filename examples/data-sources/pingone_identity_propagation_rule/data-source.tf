@@ -0,0 +1,5 @@
+data "pingone_identity_propagation_rule" "example_by_id" {
+  environment_id = var.environment_id
+
+  rule_id = var.identity_propagation_rule_id
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+data "pingone_identity_propagation_rule_mapping" "example_by_id" {
+  environment_id = var.environment_id
+
+  mapping_id = var.identity_propagation_rule_mapping_id
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "pingone_identity_propagation_store" "example_by_name" {
+  environment_id = var.environment_id
+
+  name = "My Awesome SCIM Store"
+}
+
+data "pingone_identity_propagation_store" "example_by_id" {
+  environment_id = var.environment_id
+
+  store_id = var.identity_propagation_store_id
+}
\ No newline at end of file
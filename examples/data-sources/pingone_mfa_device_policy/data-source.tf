@@ -0,0 +1,14 @@
+data "pingone_mfa_device_policy" "find_by_id_example" {
+  environment_id       = var.environment_id
+  mfa_device_policy_id = var.mfa_device_policy_id
+}
+
+data "pingone_mfa_device_policy" "find_by_name_example" {
+  environment_id = var.environment_id
+  name           = "foo"
+}
+
+data "pingone_mfa_device_policy" "find_default_policy_example" {
+  environment_id = var.environment_id
+  default        = true
+}
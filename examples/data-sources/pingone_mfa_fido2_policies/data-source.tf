@@ -0,0 +1,3 @@
+data "pingone_mfa_fido2_policies" "example_all_fido2_policy_ids" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,14 @@
+data "pingone_mfa_fido2_policy" "find_by_id_example" {
+  environment_id  = var.environment_id
+  fido2_policy_id = var.fido2_policy_id
+}
+
+data "pingone_mfa_fido2_policy" "find_by_name_example" {
+  environment_id = var.environment_id
+  name           = "foo"
+}
+
+data "pingone_mfa_fido2_policy" "find_default_policy_example" {
+  environment_id = var.environment_id
+  default        = true
+}
@@ -0,0 +1,8 @@
+data "pingone_notification_template_contents" "example_all_template_contents" {
+  environment_id = var.environment_id
+}
+
+data "pingone_notification_template_contents" "example_strong_authentication_template_contents" {
+  environment_id = var.environment_id
+  template_name  = "strong_authentication"
+}
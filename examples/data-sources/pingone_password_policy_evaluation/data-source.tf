@@ -0,0 +1,48 @@
+data "pingone_password_policy_evaluation" "example_by_id" {
+  environment_id     = var.environment_id
+  password_policy_id = var.password_policy_id
+
+  test_passwords = [
+    {
+      password = var.compliant_test_password
+    },
+    {
+      password         = var.similar_test_password
+      current_password = var.compliant_test_password
+      failed_attempts  = 5
+    },
+  ]
+}
+
+data "pingone_password_policy_evaluation" "example_inline" {
+  password_policy = {
+    length = {
+      min = 12
+      max = 255
+    }
+
+    min_characters = {
+      alphabetical_uppercase = 1
+      numeric                = 1
+    }
+
+    qwerty_sequence_rule = {
+      max_length = 3
+    }
+
+    max_repeated_characters = 2
+  }
+
+  test_passwords = [
+    {
+      password = var.compliant_test_password
+    },
+  ]
+
+  lifecycle {
+    postcondition {
+      condition     = self.results[0].valid
+      error_message = "The compliant test password violates the policy rules: ${join(", ", self.results[0].violations)}"
+    }
+  }
+}
\ No newline at end of file
@@ -14,3 +14,10 @@ data "pingone_populations" "by_data_filter" {
     }
   ]
 }
+
+data "pingone_populations" "with_details" {
+  environment_id = var.environment_id
+
+  scim_filter     = "(name eq \"My first population\") OR (name eq \"My second population\")"
+  include_details = true
+}
@@ -0,0 +1,3 @@
+data "pingone_risk_policies" "example_all_risk_policy_ids" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,14 @@
+data "pingone_risk_policy" "find_by_id_example" {
+  environment_id = var.environment_id
+  risk_policy_id = var.risk_policy_id
+}
+
+data "pingone_risk_policy" "find_by_name_example" {
+  environment_id = var.environment_id
+  name           = "foo"
+}
+
+data "pingone_risk_policy" "find_default_policy_example" {
+  environment_id = var.environment_id
+  default        = true
+}
@@ -1 +1,5 @@
 data "pingone_roles" "all_roles" {}
+
+data "pingone_roles" "all_roles_with_details" {
+  include_details = true
+}
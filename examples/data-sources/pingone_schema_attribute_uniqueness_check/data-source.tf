@@ -0,0 +1,32 @@
+data "pingone_schema_attribute_uniqueness_check" "employee_id" {
+  environment_id = var.environment_id
+
+  attribute_name = "employeeId"
+
+  lifecycle {
+    postcondition {
+      condition     = self.unique
+      error_message = "Existing users hold duplicate employeeId values: ${join(", ", [for d in self.duplicates : d.value])}"
+    }
+  }
+}
+
+resource "pingone_schema_attribute" "employee_id" {
+  environment_id = var.environment_id
+
+  name    = "employeeId"
+  type    = "STRING"
+  unique  = true
+  enabled = true
+
+  depends_on = [
+    data.pingone_schema_attribute_uniqueness_check.employee_id
+  ]
+}
+
+data "pingone_schema_attribute_uniqueness_check" "population_email" {
+  environment_id = var.environment_id
+  population_id  = var.population_id
+
+  attribute_name = "email"
+}
\ No newline at end of file
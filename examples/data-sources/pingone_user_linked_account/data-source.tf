@@ -0,0 +1,13 @@
+data "pingone_user_linked_account" "example_by_id" {
+  environment_id = var.environment_id
+  user_id        = var.user_id
+
+  linked_account_id = var.linked_account_id
+}
+
+data "pingone_user_linked_account" "example_by_identity_provider" {
+  environment_id = var.environment_id
+  user_id        = var.user_id
+
+  identity_provider_id = var.identity_provider_id
+}
\ No newline at end of file
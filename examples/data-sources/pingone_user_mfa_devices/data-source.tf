@@ -0,0 +1,4 @@
+data "pingone_user_mfa_devices" "example_by_user" {
+  environment_id = var.environment_id
+  user_id        = var.user_id
+}
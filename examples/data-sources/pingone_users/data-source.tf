@@ -22,4 +22,11 @@ data "pingone_users" "example_by_scim_filter" {
   environment_id = var.environment_id
 
   scim_filter = "(population.id eq \"${pingone_population.my_population.id}\") AND (memberOfGroups[id eq \"${pingone_group.my_first_group.id}\"] OR memberOfGroups[id eq \"${pingone_group.my_second_group.id}\"])"
-}
\ No newline at end of file
+}
+
+data "pingone_users" "example_with_details" {
+  environment_id = var.environment_id
+
+  scim_filter     = "population.id eq \"${pingone_population.my_population.id}\""
+  include_details = true
+}
@@ -0,0 +1,16 @@
+locals {
+  registration_flow = provider::pingone::davinci_flow_from_export(file("${path.module}/flows/registration.json"))
+}
+
+resource "pingone_davinci_flow" "registration" {
+  environment_id = var.environment_id
+
+  name          = local.registration_flow.name
+  description   = local.registration_flow.description
+  color         = local.registration_flow.color
+  graph_data    = local.registration_flow.graph_data
+  input_schema  = local.registration_flow.input_schema
+  output_schema = local.registration_flow.output_schema
+  settings      = local.registration_flow.settings
+  trigger       = local.registration_flow.trigger
+}
\ No newline at end of file
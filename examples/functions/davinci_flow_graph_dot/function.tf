@@ -0,0 +1,4 @@
+resource "local_file" "registration_flow_diagram" {
+  filename = "${path.module}/registration_flow.dot"
+  content  = nonsensitive(provider::pingone::davinci_flow_graph_dot(pingone_davinci_flow.registration.graph_data))
+}
\ No newline at end of file
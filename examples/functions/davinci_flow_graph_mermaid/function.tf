@@ -0,0 +1,3 @@
+output "registration_flow_diagram" {
+  value = nonsensitive(provider::pingone::davinci_flow_graph_mermaid(pingone_davinci_flow.registration.graph_data))
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import pingone_application_access_control.example <environment_id>/<application_id>
\ No newline at end of file
@@ -0,0 +1,51 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_group" "sales" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "Sales"
+}
+
+resource "pingone_group" "sales_managers" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "Sales Managers"
+}
+
+resource "pingone_application" "my_awesome_sales_app" {
+  environment_id = pingone_environment.my_environment.id
+  name           = "My Awesome Sales App"
+  enabled        = true
+
+  oidc_options = {
+    type                       = "WEB_APP"
+    grant_types                = ["AUTHORIZATION_CODE"]
+    response_types             = ["CODE"]
+    token_endpoint_auth_method = "CLIENT_SECRET_BASIC"
+    redirect_uris              = ["https://www.example.com"]
+  }
+
+  lifecycle {
+    # Access control is managed by the pingone_application_access_control resource
+    ignore_changes = [
+      access_control_role_type,
+      access_control_group_options,
+    ]
+  }
+}
+
+resource "pingone_application_access_control" "my_awesome_sales_app" {
+  environment_id = pingone_environment.my_environment.id
+  application_id = pingone_application.my_awesome_sales_app.id
+
+  group_options = {
+    type = "ANY_GROUP"
+
+    groups = [
+      pingone_group.sales.id,
+      pingone_group.sales_managers.id,
+    ]
+  }
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import pingone_davinci_connector_instance_http.example <environment_id>/<instance_id>
\ No newline at end of file
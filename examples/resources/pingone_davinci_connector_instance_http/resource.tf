@@ -0,0 +1,9 @@
+resource "pingone_davinci_connector_instance_http" "example" {
+  environment_id = var.pingone_environment_id
+
+  name = "My awesome httpConnector"
+
+  connection_id        = var.httpconnector_property_connection_id
+  recaptcha_secret_key = var.httpconnector_property_recaptcha_secret_key
+  recaptcha_site_key   = var.httpconnector_property_recaptcha_site_key
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "pingone_davinci_connector_instance_pingone_mfa" "example" {
+  environment_id = var.pingone_environment_id
+
+  name = "My awesome pingOneMfaConnector"
+
+  client_id     = var.pingone_worker_app_client_id
+  client_secret = var.pingone_worker_app_client_secret
+  env_id        = var.pingone_worker_app_environment_id
+  policy_id     = var.pingonemfaconnector_property_policy_id
+  region        = var.pingonemfaconnector_property_region
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import pingone_davinci_connector_instance_pingone_sso.example <environment_id>/<instance_id>
\ No newline at end of file
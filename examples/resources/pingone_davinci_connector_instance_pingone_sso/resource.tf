@@ -0,0 +1,10 @@
+resource "pingone_davinci_connector_instance_pingone_sso" "example" {
+  environment_id = var.pingone_environment_id
+
+  name = "My awesome pingOneSSOConnector"
+
+  client_id     = var.pingone_worker_app_client_id
+  client_secret = var.pingone_worker_app_client_secret
+  env_id        = var.pingone_worker_app_environment_id
+  region        = var.pingonessoconnector_property_region
+}
\ No newline at end of file
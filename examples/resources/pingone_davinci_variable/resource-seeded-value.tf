@@ -0,0 +1,16 @@
+resource "pingone_davinci_variable" "my_awesome_counter_variable" {
+  environment_id = var.environment_id
+
+  context   = "company"
+  data_type = "number"
+  mutable   = true
+  name      = "counter"
+
+  display_name = "Counter Variable"
+
+  value = {
+    float32 = 1
+  }
+
+  ignore_runtime_value_changes = true
+}
\ No newline at end of file
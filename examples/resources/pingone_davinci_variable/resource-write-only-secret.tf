@@ -0,0 +1,13 @@
+resource "pingone_davinci_variable" "my_awesome_api_key_variable" {
+  environment_id = var.environment_id
+
+  context   = "company"
+  data_type = "secret"
+  mutable   = false
+  name      = "apiKey"
+
+  display_name = "API Key Variable"
+
+  value_wo         = var.api_key
+  value_wo_version = 1
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import pingone_group_members.example <environment_id>/<group_id>
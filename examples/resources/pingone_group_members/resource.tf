@@ -0,0 +1,68 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_population" "my_population" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "My population of awesome identities"
+
+  lifecycle {
+    # change the `prevent_destroy` parameter value to `true` to prevent this data carrying resource from being destroyed
+    prevent_destroy = false
+  }
+}
+
+resource "pingone_group" "my_group" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "My group"
+
+  lifecycle {
+    # change the `prevent_destroy` parameter value to `true` to prevent this data carrying resource from being destroyed
+    prevent_destroy = false
+  }
+}
+
+resource "pingone_group" "nested_group" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "My nested group"
+
+  lifecycle {
+    # change the `prevent_destroy` parameter value to `true` to prevent this data carrying resource from being destroyed
+    prevent_destroy = false
+  }
+}
+
+resource "pingone_user" "foo" {
+  environment_id = pingone_environment.my_environment.id
+
+  population_id = pingone_population.my_population.id
+
+  username = "foouser"
+  email    = "foouser@pingidentity.com"
+}
+
+resource "pingone_user" "bar" {
+  environment_id = pingone_environment.my_environment.id
+
+  population_id = pingone_population.my_population.id
+
+  username = "baruser"
+  email    = "baruser@pingidentity.com"
+}
+
+resource "pingone_group_members" "my_group_members" {
+  environment_id = pingone_environment.my_environment.id
+  group_id       = pingone_group.my_group.id
+
+  user_ids = [
+    pingone_user.foo.id,
+    pingone_user.bar.id,
+  ]
+
+  nested_group_ids = [
+    pingone_group.nested_group.id,
+  ]
+}
@@ -0,0 +1 @@
+terraform import pingone_identity_propagation_rule.example <environment_id>/<identity_propagation_rule_id>
\ No newline at end of file
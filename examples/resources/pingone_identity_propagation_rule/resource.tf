@@ -0,0 +1,34 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_identity_propagation_plan" "my_awesome_propagation_plan" {
+  environment_id = pingone_environment.my_environment.id
+  name           = "My Awesome Identity Provisioning Plan"
+}
+
+resource "pingone_identity_propagation_store" "my_pingone_directory" {
+  # ...
+}
+
+resource "pingone_identity_propagation_store" "my_awesome_scim_store" {
+  # ...
+}
+
+resource "pingone_population" "my_population" {
+  # ...
+}
+
+resource "pingone_identity_propagation_rule" "my_awesome_propagation_rule" {
+  environment_id = pingone_environment.my_environment.id
+
+  plan_id         = pingone_identity_propagation_plan.my_awesome_propagation_plan.id
+  source_store_id = pingone_identity_propagation_store.my_pingone_directory.id
+  target_store_id = pingone_identity_propagation_store.my_awesome_scim_store.id
+
+  deprovision = true
+
+  population_ids = [
+    pingone_population.my_population.id,
+  ]
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import pingone_identity_propagation_rule_mapping.example <environment_id>/<rule_id>/<identity_propagation_rule_mapping_id>
\ No newline at end of file
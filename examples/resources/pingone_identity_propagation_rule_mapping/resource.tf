@@ -0,0 +1,23 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_identity_propagation_rule" "my_awesome_propagation_rule" {
+  # ...
+}
+
+resource "pingone_identity_propagation_rule_mapping" "username" {
+  environment_id = pingone_environment.my_environment.id
+  rule_id        = pingone_identity_propagation_rule.my_awesome_propagation_rule.id
+
+  source_attribute = "username"
+  target_attribute = "userName"
+}
+
+resource "pingone_identity_propagation_rule_mapping" "display_name" {
+  environment_id = pingone_environment.my_environment.id
+  rule_id        = pingone_identity_propagation_rule.my_awesome_propagation_rule.id
+
+  expression       = "$${user.name.given} $${user.name.family}"
+  target_attribute = "displayName"
+}
\ No newline at end of file
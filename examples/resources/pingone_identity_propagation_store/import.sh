@@ -0,0 +1 @@
+terraform import pingone_identity_propagation_store.example <environment_id>/<identity_propagation_store_id>
\ No newline at end of file
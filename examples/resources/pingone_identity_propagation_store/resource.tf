@@ -0,0 +1,27 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_identity_propagation_store" "my_awesome_scim_store" {
+  environment_id = pingone_environment.my_environment.id
+
+  name        = "My Awesome SCIM Store"
+  description = "Provisions users to the BXRetail SCIM service"
+  type        = "scim"
+
+  configuration = jsonencode({
+    SCIM_URL               = "https://scim.bxretail.org/scim/v2"
+    SCIM_VERSION           = "2.0"
+    AUTHENTICATION_METHOD  = "OAuth 2 Bearer Token"
+    AUTHORIZATION_TYPE     = "Bearer"
+    OAUTH_ACCESS_TOKEN     = var.scim_access_token
+    OAUTH_TOKEN_REQUEST    = "https://scim.bxretail.org/as/token"
+    UNIQUE_USER_IDENTIFIER = "userName"
+    USER_FILTER            = "userName eq \"%s\""
+    USERS_RESOURCE         = "/Users"
+    CREATE_USERS           = true
+    UPDATE_USERS           = true
+    DISABLE_USERS          = true
+    REMOVE_ACTION          = "Disable"
+  })
+}
\ No newline at end of file
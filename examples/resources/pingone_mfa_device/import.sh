@@ -0,0 +1 @@
+terraform import pingone_mfa_device.example <environment_id>/<user_id>/<mfa_device_id>
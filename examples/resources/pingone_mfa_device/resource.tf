@@ -0,0 +1,44 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_population" "my_population" {
+  # ...
+}
+
+resource "pingone_mfa_device_policy" "my_mfa_device_policy" {
+  # ...
+}
+
+resource "pingone_user" "my_test_user" {
+  environment_id = pingone_environment.my_environment.id
+
+  population_id = pingone_population.my_population.id
+
+  username = "testuser"
+  email    = "testuser@bxretail.org"
+}
+
+resource "pingone_mfa_device" "my_test_user_email" {
+  environment_id       = pingone_environment.my_environment.id
+  user_id              = pingone_user.my_test_user.id
+  mfa_device_policy_id = pingone_mfa_device_policy.my_mfa_device_policy.id
+
+  nickname = "Work email"
+
+  email = {
+    email = "testuser@bxretail.org"
+  }
+}
+
+resource "pingone_mfa_device" "my_test_user_totp" {
+  environment_id = pingone_environment.my_environment.id
+  user_id        = pingone_user.my_test_user.id
+
+  totp = {}
+}
+
+output "test_user_totp_secret" {
+  value     = pingone_mfa_device.my_test_user_totp.totp.secret
+  sensitive = true
+}
@@ -13,6 +13,22 @@ resource "pingone_population" "my_population" {
   }
 }
 
+resource "pingone_schema_attribute" "cost_centre" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "costCentre"
+  type = "STRING"
+
+  enumerated_values = [
+    {
+      value = "CC-1001"
+    },
+    {
+      value = "CC-2002"
+    }
+  ]
+}
+
 resource "pingone_user" "foo" {
   environment_id = pingone_environment.my_environment.id
 
@@ -20,4 +36,8 @@ resource "pingone_user" "foo" {
 
   username = "foouser"
   email    = "foouser@pingidentity.com"
+
+  custom_attributes = jsonencode({
+    (pingone_schema_attribute.cost_centre.name) = "CC-1001"
+  })
 }
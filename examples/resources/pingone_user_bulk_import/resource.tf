@@ -0,0 +1,38 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_population" "migrated_users" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "Migrated users"
+
+  lifecycle {
+    # change the `prevent_destroy` parameter value to `true` to prevent this data carrying resource from being destroyed
+    prevent_destroy = false
+  }
+}
+
+# users.csv:
+# username,email,given_name,family_name,password_hash
+# user1,user1@bxretail.org,Jane,Doe,{SSHA512}UkGWfORubNKFpFBWh+Lgy4FrciclzUXneuryV+B+zBDR4Gqd5wvMqAvKRixgQWoZlZUgq8Wh40uMK3s6bWpzWt1/TqQH02hX
+resource "pingone_user_bulk_import" "migrated_users" {
+  environment_id = pingone_environment.my_environment.id
+  population_id  = pingone_population.migrated_users.id
+
+  csv_content = file("${path.module}/users.csv")
+}
+
+resource "pingone_user_bulk_import" "test_users" {
+  environment_id = pingone_environment.my_environment.id
+  population_id  = pingone_population.migrated_users.id
+
+  users = [
+    for i in range(1, 1001) : {
+      username = format("test-user-%04d", i)
+      email    = format("test-user-%04d@bxretail.org", i)
+    }
+  ]
+
+  delete_users = true
+}
\ No newline at end of file
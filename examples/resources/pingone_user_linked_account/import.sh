@@ -0,0 +1 @@
+terraform import pingone_user_linked_account.example <environment_id>/<user_id>/<linked_account_id>
\ No newline at end of file
@@ -0,0 +1,32 @@
+resource "pingone_environment" "my_environment" {
+  # ...
+}
+
+resource "pingone_population" "my_population" {
+  # ...
+}
+
+resource "pingone_user" "my_user" {
+  environment_id = pingone_environment.my_environment.id
+
+  username      = "user123"
+  email         = "user123@bxretail.org"
+  population_id = pingone_population.my_population.id
+}
+
+resource "pingone_identity_provider" "legacy_idp" {
+  environment_id = pingone_environment.my_environment.id
+
+  name = "Legacy Identity Provider"
+
+  openid_connect = {
+    # ...
+  }
+}
+
+resource "pingone_user_linked_account" "my_user_legacy_idp" {
+  environment_id       = pingone_environment.my_environment.id
+  user_id              = pingone_user.my_user.id
+  identity_provider_id = pingone_identity_provider.legacy_idp.id
+  external_id          = "00u1a2b3c4d5e6f7g8h9"
+}
\ No newline at end of file
@@ -0,0 +1,90 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	frameworklegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+func IdentityPropagationRule_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := legacysdk.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	apiClient := p1Client.API.ManagementAPIClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingone_identity_propagation_rule" {
+			continue
+		}
+
+		shouldContinue, err := legacysdk.CheckParentEnvironmentDestroy(ctx, apiClient, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		r, err := frameworklegacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, fmt.Sprintf("/environments/%s/propagation/rules/%s", rs.Primary.Attributes["environment_id"], rs.Primary.ID), "", nil, nil)
+
+		shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		return fmt.Errorf("PingOne Identity Propagation Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func IdentityPropagationRule_GetIDs(resourceName string, environmentID, resourceID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if resourceID != nil {
+			*resourceID = rs.Primary.ID
+		}
+
+		if environmentID != nil {
+			*environmentID = rs.Primary.Attributes["environment_id"]
+		}
+
+		return nil
+	}
+}
+
+func IdentityPropagationRule_RemovalDrift_PreConfig(ctx context.Context, apiClient *management.APIClient, t *testing.T, environmentID, identityPropagationRuleID string) {
+	if environmentID == "" || identityPropagationRuleID == "" {
+		t.Fatalf("One of environment ID or identity propagation rule ID cannot be determined. Environment ID: %s, Identity Propagation Rule ID: %s", environmentID, identityPropagationRuleID)
+	}
+
+	_, err := frameworklegacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodDelete, fmt.Sprintf("/environments/%s/propagation/rules/%s", environmentID, identityPropagationRuleID), "", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to delete Identity Propagation Rule: %v", err)
+	}
+}
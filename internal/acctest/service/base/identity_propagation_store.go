@@ -0,0 +1,88 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+)
+
+func IdentityPropagationStore_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := legacysdk.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	apiClient := p1Client.API.ManagementAPIClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingone_identity_propagation_store" {
+			continue
+		}
+
+		shouldContinue, err := legacysdk.CheckParentEnvironmentDestroy(ctx, apiClient, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		_, r, err := apiClient.PropagationStoresApi.ReadOnePropagationStore(ctx, rs.Primary.Attributes["environment_id"], rs.Primary.ID).Execute()
+
+		shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		return fmt.Errorf("PingOne Identity Propagation Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func IdentityPropagationStore_GetIDs(resourceName string, environmentID, resourceID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if resourceID != nil {
+			*resourceID = rs.Primary.ID
+		}
+
+		if environmentID != nil {
+			*environmentID = rs.Primary.Attributes["environment_id"]
+		}
+
+		return nil
+	}
+}
+
+func IdentityPropagationStore_RemovalDrift_PreConfig(ctx context.Context, apiClient *management.APIClient, t *testing.T, environmentID, identityPropagationStoreID string) {
+	if environmentID == "" || identityPropagationStoreID == "" {
+		t.Fatalf("One of environment ID or identity propagation store ID cannot be determined. Environment ID: %s, Identity Propagation Store ID: %s", environmentID, identityPropagationStoreID)
+	}
+
+	_, err := apiClient.PropagationStoresApi.DeletePropagationStore(ctx, environmentID, identityPropagationStoreID).Execute()
+	if err != nil {
+		t.Fatalf("Failed to delete Identity Propagation Store: %v", err)
+	}
+}
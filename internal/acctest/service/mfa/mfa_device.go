@@ -0,0 +1,94 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	frameworklegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+func MFADevice_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := legacysdk.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	apiClient := p1Client.API.ManagementAPIClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingone_mfa_device" {
+			continue
+		}
+
+		shouldContinue, err := legacysdk.CheckParentEnvironmentDestroy(ctx, p1Client.API.ManagementAPIClient, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		r, err := frameworklegacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, fmt.Sprintf("/environments/%s/users/%s/devices/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID), "", nil, nil)
+
+		shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		return fmt.Errorf("PingOne MFA device %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func MFADevice_GetIDs(resourceName string, environmentID, userID, resourceID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if resourceID != nil {
+			*resourceID = rs.Primary.ID
+		}
+
+		if userID != nil {
+			*userID = rs.Primary.Attributes["user_id"]
+		}
+
+		if environmentID != nil {
+			*environmentID = rs.Primary.Attributes["environment_id"]
+		}
+
+		return nil
+	}
+}
+
+func MFADevice_RemovalDrift_PreConfig(ctx context.Context, apiClient *management.APIClient, t *testing.T, environmentID, userID, mfaDeviceID string) {
+	if environmentID == "" || userID == "" || mfaDeviceID == "" {
+		t.Fatalf("One of environment ID, user ID or MFA device ID cannot be determined. Environment ID: %s, User ID: %s, MFA device ID: %s", environmentID, userID, mfaDeviceID)
+	}
+
+	_, err := frameworklegacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodDelete, fmt.Sprintf("/environments/%s/users/%s/devices/%s", environmentID, userID, mfaDeviceID), "", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to delete MFA device: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+)
+
+func ApplicationAccessControl_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := legacysdk.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	apiClient := p1Client.API.ManagementAPIClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingone_application_access_control" {
+			continue
+		}
+
+		shouldContinue, err := legacysdk.CheckParentEnvironmentDestroy(ctx, p1Client.API.ManagementAPIClient, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		body, r, err := apiClient.ApplicationsApi.ReadOneApplication(ctx, rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["application_id"]).Execute()
+
+		shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		var accessControl *management.ApplicationAccessControl
+		switch v := body.GetActualInstance().(type) {
+		case *management.ApplicationExternalLink:
+			accessControl = v.AccessControl
+		case *management.ApplicationOIDC:
+			accessControl = v.AccessControl
+		case *management.ApplicationPingOnePortal:
+			accessControl = v.AccessControl
+		case *management.ApplicationPingOneSelfService:
+			accessControl = v.AccessControl
+		case *management.ApplicationSAML:
+			accessControl = v.AccessControl
+		case *management.ApplicationWSFED:
+			accessControl = v.AccessControl
+		}
+
+		if accessControl == nil || (accessControl.Role == nil && accessControl.Group == nil) {
+			continue
+		}
+
+		return fmt.Errorf("PingOne Application %s access control still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func ApplicationAccessControl_GetIDs(resourceName string, environmentID, applicationID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if applicationID != nil {
+			*applicationID = rs.Primary.Attributes["application_id"]
+		}
+
+		if environmentID != nil {
+			*environmentID = rs.Primary.Attributes["environment_id"]
+		}
+
+		return nil
+	}
+}
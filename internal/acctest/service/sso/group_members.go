@@ -0,0 +1,118 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+)
+
+func GroupMembers_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := legacysdk.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	apiClient := p1Client.API.ManagementAPIClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingone_group_members" {
+			continue
+		}
+
+		shouldContinue, err := legacysdk.CheckParentEnvironmentDestroy(ctx, p1Client.API.ManagementAPIClient, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		environmentID := rs.Primary.Attributes["environment_id"]
+		groupID := rs.Primary.Attributes["group_id"]
+
+		// The memberships managed by the resource must have been removed, whether or not the group itself still exists
+		for k, userID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "user_ids.") || k == "user_ids.#" {
+				continue
+			}
+
+			_, r, err := apiClient.GroupMembershipApi.ReadOneGroupMembershipForUser(ctx, environmentID, userID, groupID).Execute()
+
+			shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+			if err != nil {
+				return err
+			}
+
+			if shouldContinue {
+				continue
+			}
+
+			return fmt.Errorf("PingOne user %s is still a member of group %s", userID, groupID)
+		}
+
+		for k, nestedGroupID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "nested_group_ids.") || k == "nested_group_ids.#" {
+				continue
+			}
+
+			_, r, err := apiClient.GroupsApi.ReadOneGroupNesting(ctx, environmentID, groupID, nestedGroupID).Execute()
+
+			shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+			if err != nil {
+				return err
+			}
+
+			if shouldContinue {
+				continue
+			}
+
+			return fmt.Errorf("PingOne group %s is still nested in group %s", nestedGroupID, groupID)
+		}
+	}
+
+	return nil
+}
+
+func GroupMembers_GetIDs(resourceName string, environmentID, groupID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if groupID != nil {
+			*groupID = rs.Primary.Attributes["group_id"]
+		}
+
+		if environmentID != nil {
+			*environmentID = rs.Primary.Attributes["environment_id"]
+		}
+
+		return nil
+	}
+}
+
+func GroupMembers_UnmanagedMember_PreConfig(ctx context.Context, apiClient *management.APIClient, t *testing.T, environmentID, groupID, userID string) {
+	if environmentID == "" || groupID == "" || userID == "" {
+		t.Fatalf("One of environment ID, group ID or user ID cannot be determined. Environment ID: %s, Group ID: %s, User ID: %s", environmentID, groupID, userID)
+	}
+
+	_, _, err := apiClient.GroupMembershipApi.AddUserToGroup(ctx, environmentID, userID).GroupMembership(*management.NewGroupMembership(groupID)).Execute()
+	if err != nil {
+		t.Fatalf("Failed to add user to group: %v", err)
+	}
+}
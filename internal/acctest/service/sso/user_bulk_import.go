@@ -0,0 +1,107 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+)
+
+func UserBulkImport_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := legacysdk.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	apiClient := p1Client.API.ManagementAPIClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingone_user_bulk_import" {
+			continue
+		}
+
+		// Users are left in place unless configured otherwise
+		if rs.Primary.Attributes["delete_users"] != "true" {
+			continue
+		}
+
+		shouldContinue, err := legacysdk.CheckParentEnvironmentDestroy(ctx, p1Client.API.ManagementAPIClient, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		for k, userID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "user_ids.") || k == "user_ids.%" {
+				continue
+			}
+
+			_, r, err := apiClient.UsersApi.ReadUser(ctx, rs.Primary.Attributes["environment_id"], userID).Execute()
+
+			shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+			if err != nil {
+				return err
+			}
+
+			if shouldContinue {
+				continue
+			}
+
+			return fmt.Errorf("PingOne user %s imported by %s still exists", userID, rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func UserBulkImport_GetUserID(resourceName, username string, environmentID, userID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if userID != nil {
+			*userID = rs.Primary.Attributes[fmt.Sprintf("user_ids.%s", username)]
+		}
+
+		if environmentID != nil {
+			*environmentID = rs.Primary.Attributes["environment_id"]
+		}
+
+		return nil
+	}
+}
+
+func UserBulkImport_ChangedUser_PreConfig(ctx context.Context, apiClient *management.APIClient, t *testing.T, environmentID, userID string) {
+	if environmentID == "" || userID == "" {
+		t.Fatalf("One of environment ID or user ID cannot be determined. Environment ID: %s, User ID: %s", environmentID, userID)
+	}
+
+	user, _, err := apiClient.UsersApi.ReadUser(ctx, environmentID, userID).Execute()
+	if err != nil {
+		t.Fatalf("Failed to read user: %v", err)
+	}
+
+	user.SetEmail(fmt.Sprintf("changed-%s", user.GetEmail()))
+
+	_, _, err = apiClient.UsersApi.UpdateUserPatch(ctx, environmentID, userID).User(*user).Execute()
+	if err != nil {
+		t.Fatalf("Failed to update user: %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package legacysdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+)
+
+// ManagementAPIRawRequest calls a PingOne platform API endpoint that the SDK does not model, using the connection settings (base URL, authorization and HTTP client) of the management API client.
+// See framework.RawRequest for how the request body, response body and errors are handled.
+func ManagementAPIRawRequest(ctx context.Context, apiClient *management.APIClient, method, requestPath, contentType string, body any, target any) (*http.Response, error) {
+	cfg := apiClient.GetConfig()
+
+	basePath, err := cfg.ServerURLWithContext(ctx, "UsersApiService.ReadUser")
+	if err != nil {
+		return nil, err
+	}
+
+	return framework.RawRequest(ctx, framework.RawRequestConfig{
+		HTTPClient:    cfg.HTTPClient,
+		UserAgent:     cfg.UserAgent,
+		DefaultHeader: cfg.DefaultHeader,
+	}, method, basePath+requestPath, contentType, body, target)
+}
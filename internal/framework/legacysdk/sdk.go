@@ -123,6 +123,33 @@ func ParseResponseWithCustomTimeout(ctx context.Context, f framework.SDKInterfac
 
 			return diags
 
+		case *framework.RawRequestError:
+
+			p1Error, err := model.RemarshalErrorObj(t)
+			if err != nil || p1Error == nil {
+				tflog.Warn(ctx, fmt.Sprintf("Cannot remarshal the raw request error %v", err))
+				p1Error = &model.P1Error{}
+			}
+
+			diags = customError(r, p1Error)
+			if diags != nil {
+				return diags
+			}
+
+			if p1Error.GetId() != "" {
+				summaryText, detailText := sdk.FormatPingOneError(requestID, *p1Error)
+
+				diags.AddError(summaryText, detailText)
+
+				return diags
+			}
+
+			diags.AddError(fmt.Sprintf("Error when calling `%s`: %v", requestID, t.Error()), "")
+
+			tflog.Error(ctx, fmt.Sprintf("Error when calling `%s`: %v\n\n%s", requestID, t.Error(), utils.ResponseErrorDetails(r)))
+
+			return diags
+
 		case *url.Error:
 			tflog.Warn(ctx, fmt.Sprintf("Detected HTTP error %s\n\n%s", t.Err.Error(), utils.ResponseErrorDetails(r)))
 
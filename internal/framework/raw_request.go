@@ -0,0 +1,116 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pingidentity/pingone-go-client/pingone"
+)
+
+// RawRequestConfig holds the connection settings of an API client used to send a raw request
+type RawRequestConfig struct {
+	HTTPClient    *http.Client
+	UserAgent     string
+	DefaultHeader map[string]string
+}
+
+// APIRawRequest calls a PingOne API endpoint that the client does not model, such as a HAL link returned in a response, using the connection settings (authorization and HTTP client) of the API client.
+// See RawRequest for how the request body, response body and errors are handled.
+func APIRawRequest(ctx context.Context, apiClient *pingone.APIClient, method, requestURL, contentType string, body any, target any) (*http.Response, error) {
+	cfg := apiClient.GetConfig()
+
+	return RawRequest(ctx, RawRequestConfig{
+		HTTPClient:    cfg.HTTPClient,
+		UserAgent:     cfg.UserAgent,
+		DefaultHeader: cfg.DefaultHeader,
+	}, method, requestURL, contentType, body, target)
+}
+
+// RawRequestError is returned by RawRequest for HTTP statuses of 300 and above.  It marshals to the PingOne error object in the response body, so that ParseResponse and the custom error handlers can read the error as they do for errors returned by the API clients.
+type RawRequestError struct {
+	Status string
+	Body   []byte
+}
+
+func (e *RawRequestError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Status, string(e.Body))
+}
+
+// MarshalJSON returns the response body, or an error object holding the error message where the response body is not JSON
+func (e *RawRequestError) MarshalJSON() ([]byte, error) {
+	if json.Valid(e.Body) {
+		return e.Body, nil
+	}
+
+	return json.Marshal(map[string]string{"message": e.Error()})
+}
+
+// RawRequest sends a request to the URL with the given connection settings.
+// The request body, if not nil, is sent as JSON with the given content type.  The response body is decoded into target, if not nil, and is left re-readable on the returned response.
+// A *RawRequestError is returned for HTTP statuses of 300 and above, as required by ParseResponse.
+func RawRequest(ctx context.Context, cfg RawRequestConfig, method, requestURL, contentType string, body any, target any) (*http.Response, error) {
+	var requestBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		requestBody = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	for header, value := range cfg.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	httpResponse, err := httpClient.Do(req)
+	if err != nil {
+		return httpResponse, err
+	}
+
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	httpResponse.Body = io.NopCloser(bytes.NewBuffer(responseBody))
+	if err != nil {
+		return httpResponse, err
+	}
+
+	if httpResponse.StatusCode >= 300 {
+		return httpResponse, &RawRequestError{
+			Status: httpResponse.Status,
+			Body:   responseBody,
+		}
+	}
+
+	if target != nil && len(responseBody) > 0 {
+		if err := json.Unmarshal(responseBody, target); err != nil {
+			return httpResponse, err
+		}
+	}
+
+	return httpResponse, nil
+}
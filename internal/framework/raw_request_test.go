@@ -0,0 +1,127 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRawRequest_Success(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected method %s, got %s", http.MethodPatch, r.Method)
+		}
+
+		for header, expected := range map[string]string{
+			"Content-Type":  "application/vnd.pingidentity.test+json",
+			"Accept":        "application/json",
+			"User-Agent":    "test-agent",
+			"Authorization": "Bearer token",
+		} {
+			if got := r.Header.Get(header); got != expected {
+				t.Errorf("expected header %s to be %q, got %q", header, expected, got)
+			}
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["name"] != "test" {
+			t.Errorf("unexpected request body: %v (%v)", body, err)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"1234"}`))
+	}))
+	defer server.Close()
+
+	cfg := RawRequestConfig{
+		HTTPClient:    server.Client(),
+		UserAgent:     "test-agent",
+		DefaultHeader: map[string]string{"Authorization": "Bearer token"},
+	}
+
+	var target map[string]string
+	httpResponse, err := RawRequest(context.Background(), cfg, http.MethodPatch, server.URL+"/test", "application/vnd.pingidentity.test+json", map[string]string{"name": "test"}, &target)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if target["id"] != "1234" {
+		t.Errorf("expected the response to be decoded into the target, got %v", target)
+	}
+
+	// The response body must remain readable for error handling
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil || string(body) != `{"id":"1234"}` {
+		t.Errorf("expected the response body to be re-readable, got %q (%v)", string(body), err)
+	}
+}
+
+func TestRawRequest_Error(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Type"); got != "" {
+			t.Errorf("expected no content type for a request without a body, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"NOT_FOUND"}`))
+	}))
+	defer server.Close()
+
+	var target map[string]string
+	httpResponse, err := RawRequest(context.Background(), RawRequestConfig{}, http.MethodGet, server.URL+"/test", "", nil, &target)
+	if err == nil {
+		t.Fatalf("expected an error for a 404 response")
+	}
+
+	var rawRequestError *RawRequestError
+	if !errors.As(err, &rawRequestError) {
+		t.Fatalf("expected a *RawRequestError, got %T", err)
+	}
+
+	if !strings.Contains(err.Error(), `{"code":"NOT_FOUND"}`) {
+		t.Errorf("expected the error to include the response body, got %q", err.Error())
+	}
+
+	if httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the 404 response to be returned")
+	}
+
+	if target != nil {
+		t.Errorf("expected the target not to be decoded for an error response, got %v", target)
+	}
+}
+
+func TestRawRequest_ParseResponseCustomError(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"id":"1234","code":"NOT_FOUND","message":"The requested resource was not found."}`))
+	}))
+	defer server.Close()
+
+	diags := ParseResponse(
+		context.Background(),
+
+		func() (any, *http.Response, error) {
+			fR, fErr := RawRequest(context.Background(), RawRequestConfig{}, http.MethodGet, server.URL+"/test", "", nil, nil)
+			return nil, fR, fErr
+		},
+		"RawRequest",
+		CustomErrorResourceNotFoundWarning,
+		nil,
+		nil,
+	)
+
+	if diags.HasError() || diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "The requested resource was not found.") {
+		t.Fatalf("expected the not found custom error to be applied, got %v", diags)
+	}
+}
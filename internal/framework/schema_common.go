@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -194,3 +195,29 @@ func Attr_DataSourceReturnIDsByElementSet(description SchemaAttributeDescription
 		ElementType:         elementType,
 	}
 }
+
+func Attr_DataSourceIncludeDetails(description SchemaAttributeDescription) schema.BoolAttribute {
+	description = description.AppendMarkdownString("Defaults to `false`.")
+
+	return schema.BoolAttribute{
+		Description:         description.Description,
+		MarkdownDescription: description.MarkdownDescription,
+		Optional:            true,
+	}
+}
+
+func Attr_DataSourceReturnItems(description SchemaAttributeDescription, attributes map[string]datasourceschema.Attribute) datasourceschema.ListNestedAttribute {
+	if description.MarkdownDescription == "" {
+		description.MarkdownDescription = description.Description
+	}
+
+	return datasourceschema.ListNestedAttribute{
+		Description:         description.Description,
+		MarkdownDescription: description.MarkdownDescription,
+		Computed:            true,
+
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
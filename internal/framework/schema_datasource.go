@@ -0,0 +1,407 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// computedAttributeSchema is the set of methods shared by resource and data source schema attributes that are needed to build an
+// equivalent computed data source attribute.
+type computedAttributeSchema interface {
+	GetDescription() string
+	GetMarkdownDescription() string
+	GetDeprecationMessage() string
+	IsSensitive() bool
+	GetType() attr.Type
+}
+
+type computedAttributeNestingMode int
+
+const (
+	computedAttributeNestingModeNone computedAttributeNestingMode = iota
+	computedAttributeNestingModeSingle
+	computedAttributeNestingModeList
+	computedAttributeNestingModeSet
+	computedAttributeNestingModeMap
+)
+
+// computedAttributeSource describes a resource or data source schema attribute in a form that is independent of the schema package
+// it was declared in.  Nested attributes carry their child attributes already converted to computed data source attributes.
+type computedAttributeSource struct {
+	schema                 computedAttributeSchema
+	nestingMode            computedAttributeNestingMode
+	nestedCustomType       attr.Type
+	nestedAttributes       map[string]datasourceschema.Attribute
+	nestedObjectCustomType basetypes.ObjectTypable
+}
+
+// DataSourceComputedAttributesFromResourceAttributes converts a map of resource schema attributes into the equivalent data source
+// schema attributes, where every attribute is computed.  Validators, plan modifiers and defaults are not carried over, and write-only
+// attributes are omitted.  This allows data sources that return the same object as a managed resource to reuse the resource's schema
+// definition (and therefore the resource's state model) rather than maintain a parallel copy.
+func DataSourceComputedAttributesFromResourceAttributes(attributes map[string]schema.Attribute) (map[string]datasourceschema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	returnVar := make(map[string]datasourceschema.Attribute, len(attributes))
+
+	for k, v := range attributes {
+		if v.IsWriteOnly() {
+			continue
+		}
+
+		source, d := computedAttributeSourceFromResourceAttribute(v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attribute, d := dataSourceComputedAttribute(k, source)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		returnVar[k] = attribute
+	}
+
+	return returnVar, diags
+}
+
+func computedAttributeSourceFromResourceAttribute(attribute schema.Attribute) (computedAttributeSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	source := computedAttributeSource{
+		schema: attribute,
+	}
+
+	switch v := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeSingle
+		source.nestedCustomType = v.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromResourceAttributes(v.Attributes)
+
+	case schema.ListNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeList
+		source.nestedCustomType = v.CustomType
+		source.nestedObjectCustomType = v.NestedObject.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromResourceAttributes(v.NestedObject.Attributes)
+
+	case schema.SetNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeSet
+		source.nestedCustomType = v.CustomType
+		source.nestedObjectCustomType = v.NestedObject.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromResourceAttributes(v.NestedObject.Attributes)
+
+	case schema.MapNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeMap
+		source.nestedCustomType = v.CustomType
+		source.nestedObjectCustomType = v.NestedObject.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromResourceAttributes(v.NestedObject.Attributes)
+	}
+
+	return source, diags
+}
+
+// DataSourceComputedAttributesFromDataSourceAttributes converts a map of data source schema attributes into an equivalent map where
+// every attribute is computed.  Lookup attributes that are optional or required on the source data source become read-only, and their
+// validators are not carried over.  This allows plural data sources to return objects with the same shape as the singular data source.
+func DataSourceComputedAttributesFromDataSourceAttributes(attributes map[string]datasourceschema.Attribute) (map[string]datasourceschema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	returnVar := make(map[string]datasourceschema.Attribute, len(attributes))
+
+	for k, v := range attributes {
+		source, d := computedAttributeSourceFromDataSourceAttribute(v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attribute, d := dataSourceComputedAttribute(k, source)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		returnVar[k] = attribute
+	}
+
+	return returnVar, diags
+}
+
+func computedAttributeSourceFromDataSourceAttribute(attribute datasourceschema.Attribute) (computedAttributeSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	source := computedAttributeSource{
+		schema: attribute,
+	}
+
+	switch v := attribute.(type) {
+	case datasourceschema.SingleNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeSingle
+		source.nestedCustomType = v.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromDataSourceAttributes(v.Attributes)
+
+	case datasourceschema.ListNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeList
+		source.nestedCustomType = v.CustomType
+		source.nestedObjectCustomType = v.NestedObject.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromDataSourceAttributes(v.NestedObject.Attributes)
+
+	case datasourceschema.SetNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeSet
+		source.nestedCustomType = v.CustomType
+		source.nestedObjectCustomType = v.NestedObject.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromDataSourceAttributes(v.NestedObject.Attributes)
+
+	case datasourceschema.MapNestedAttribute:
+		source.nestingMode = computedAttributeNestingModeMap
+		source.nestedCustomType = v.CustomType
+		source.nestedObjectCustomType = v.NestedObject.CustomType
+		source.nestedAttributes, diags = DataSourceComputedAttributesFromDataSourceAttributes(v.NestedObject.Attributes)
+	}
+
+	return source, diags
+}
+
+// dataSourceComputedAttribute builds the computed data source attribute for a converted resource or data source attribute.  Primitive,
+// collection and object attributes are selected from the attribute's type, so that custom types are retained.
+func dataSourceComputedAttribute(name string, source computedAttributeSource) (datasourceschema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v := source.schema
+
+	if source.nestingMode != computedAttributeNestingModeNone {
+		nestedObject := datasourceschema.NestedAttributeObject{
+			Attributes: source.nestedAttributes,
+			CustomType: source.nestedObjectCustomType,
+		}
+
+		switch source.nestingMode {
+		case computedAttributeNestingModeSingle:
+			customType, _ := source.nestedCustomType.(basetypes.ObjectTypable)
+
+			return datasourceschema.SingleNestedAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				Attributes:          source.nestedAttributes,
+				CustomType:          customType,
+			}, diags
+
+		case computedAttributeNestingModeList:
+			customType, _ := source.nestedCustomType.(basetypes.ListTypable)
+
+			return datasourceschema.ListNestedAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				NestedObject:        nestedObject,
+				CustomType:          customType,
+			}, diags
+
+		case computedAttributeNestingModeSet:
+			customType, _ := source.nestedCustomType.(basetypes.SetTypable)
+
+			return datasourceschema.SetNestedAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				NestedObject:        nestedObject,
+				CustomType:          customType,
+			}, diags
+
+		case computedAttributeNestingModeMap:
+			customType, _ := source.nestedCustomType.(basetypes.MapTypable)
+
+			return datasourceschema.MapNestedAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				NestedObject:        nestedObject,
+				CustomType:          customType,
+			}, diags
+		}
+	}
+
+	switch t := v.GetType().(type) {
+	case basetypes.StringTypable:
+		return datasourceschema.StringAttribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.BoolTypable:
+		return datasourceschema.BoolAttribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.Int32Typable:
+		return datasourceschema.Int32Attribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.Int64Typable:
+		return datasourceschema.Int64Attribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.Float32Typable:
+		return datasourceschema.Float32Attribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.Float64Typable:
+		return datasourceschema.Float64Attribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.NumberTypable:
+		return datasourceschema.NumberAttribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.DynamicTypable:
+		return datasourceschema.DynamicAttribute{
+			Description:         v.GetDescription(),
+			MarkdownDescription: v.GetMarkdownDescription(),
+			DeprecationMessage:  v.GetDeprecationMessage(),
+			Sensitive:           v.IsSensitive(),
+			Computed:            true,
+			CustomType:          t,
+		}, diags
+
+	case basetypes.ListTypable:
+		if e, ok := t.(attr.TypeWithElementType); ok {
+			return datasourceschema.ListAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				ElementType:         e.ElementType(),
+				CustomType:          t,
+			}, diags
+		}
+
+	case basetypes.SetTypable:
+		if e, ok := t.(attr.TypeWithElementType); ok {
+			return datasourceschema.SetAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				ElementType:         e.ElementType(),
+				CustomType:          t,
+			}, diags
+		}
+
+	case basetypes.MapTypable:
+		if e, ok := t.(attr.TypeWithElementType); ok {
+			return datasourceschema.MapAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				ElementType:         e.ElementType(),
+				CustomType:          t,
+			}, diags
+		}
+
+	case basetypes.ObjectTypable:
+		if o, ok := t.(attr.TypeWithAttributeTypes); ok {
+			return datasourceschema.ObjectAttribute{
+				Description:         v.GetDescription(),
+				MarkdownDescription: v.GetMarkdownDescription(),
+				DeprecationMessage:  v.GetDeprecationMessage(),
+				Sensitive:           v.IsSensitive(),
+				Computed:            true,
+				AttributeTypes:      o.AttributeTypes(),
+				CustomType:          t,
+			}, diags
+		}
+	}
+
+	diags.AddError(
+		"Unsupported schema attribute type",
+		fmt.Sprintf("Cannot convert the schema attribute \"%s\" of type %T to a computed data source schema attribute.  Please report this to the provider maintainers.", name, v),
+	)
+
+	return nil, diags
+}
+
+// DataSourceItemsToTF converts a slice of data source models into the list value of an attribute created with Attr_DataSourceReturnItems.
+// The list type is the type of the `items` attribute in the data source schema, and each model must carry `tfsdk` struct tags that match
+// the nested attributes.  A nil slice returns a null list.
+func DataSourceItemsToTF[T any](ctx context.Context, listType attr.Type, items []T) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := listType.(types.ListType)
+	if !ok {
+		diags.AddError(
+			"Unexpected items attribute type",
+			fmt.Sprintf("Expected the items attribute to be a list, got: %T.  Please report this to the provider maintainers.", listType),
+		)
+
+		return types.ListUnknown(types.ObjectType{}), diags
+	}
+
+	if items == nil {
+		return types.ListNull(v.ElemType), diags
+	}
+
+	return types.ListValueFrom(ctx, v.ElemType, items)
+}
@@ -0,0 +1,210 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+)
+
+func TestDataSourceComputedAttributesFromResourceAttributes_Success(t *testing.T) {
+
+	in := map[string]schema.Attribute{
+		"id": Attr_ID(),
+		"name": schema.StringAttribute{
+			Description: "name",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"secret": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"secret_wo": schema.StringAttribute{
+			Optional:  true,
+			WriteOnly: true,
+		},
+		"labels": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"settings": schema.ObjectAttribute{
+			AttributeTypes: map[string]attr.Type{
+				"enabled": types.BoolType,
+			},
+			Optional: true,
+		},
+		"nested": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"values": schema.SetAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+
+	got, diags := DataSourceComputedAttributesFromResourceAttributes(in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if _, ok := got["secret_wo"]; ok {
+		t.Fatalf("expected write-only attribute to be omitted")
+	}
+
+	if len(got) != len(in)-1 {
+		t.Fatalf("expected %d attributes, got %d", len(in)-1, len(got))
+	}
+
+	for k, v := range got {
+		if !v.IsComputed() || v.IsOptional() || v.IsRequired() {
+			t.Fatalf("expected attribute %s to be computed only", k)
+		}
+	}
+
+	if _, ok := got["id"].(datasourceschema.StringAttribute).CustomType.(pingonetypes.ResourceIDType); !ok {
+		t.Fatalf("expected custom type to be retained on attribute id")
+	}
+
+	if v := got["name"].(datasourceschema.StringAttribute); v.Description != "name" || len(v.Validators) != 0 {
+		t.Fatalf("expected description to be retained and validators to be removed on attribute name")
+	}
+
+	if !got["secret"].IsSensitive() {
+		t.Fatalf("expected attribute secret to be sensitive")
+	}
+
+	if v, ok := got["labels"].(datasourceschema.MapAttribute); !ok || !v.ElementType.Equal(types.StringType) {
+		t.Fatalf("expected attribute labels to be a map of strings")
+	}
+
+	if v, ok := got["settings"].(datasourceschema.ObjectAttribute); !ok || !v.AttributeTypes["enabled"].Equal(types.BoolType) {
+		t.Fatalf("expected attribute settings to be an object with attribute types retained")
+	}
+
+	nested := got["nested"].(datasourceschema.ListNestedAttribute)
+	if v, ok := nested.NestedObject.Attributes["values"].(datasourceschema.SetAttribute); !ok || !v.Computed || !v.ElementType.Equal(types.StringType) {
+		t.Fatalf("expected nested attribute values to be a computed set of strings")
+	}
+}
+
+func TestDataSourceComputedAttributesFromDataSourceAttributes_Success(t *testing.T) {
+
+	in := map[string]datasourceschema.Attribute{
+		"id": datasourceschema.StringAttribute{
+			Computed:   true,
+			CustomType: pingonetypes.ResourceIDType{},
+		},
+		"environment_id": datasourceschema.StringAttribute{
+			Required:   true,
+			CustomType: pingonetypes.ResourceIDType{},
+		},
+		"name": datasourceschema.StringAttribute{
+			Description: "name",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"nested": datasourceschema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]datasourceschema.Attribute{
+				"values": datasourceschema.SetAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	got, diags := DataSourceComputedAttributesFromDataSourceAttributes(in)
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if len(got) != len(in) {
+		t.Fatalf("expected %d attributes, got %d", len(in), len(got))
+	}
+
+	for k, v := range got {
+		if !v.IsComputed() || v.IsOptional() || v.IsRequired() {
+			t.Fatalf("expected attribute %s to be computed only", k)
+		}
+	}
+
+	if _, ok := got["environment_id"].(datasourceschema.StringAttribute).CustomType.(pingonetypes.ResourceIDType); !ok {
+		t.Fatalf("expected custom type to be retained on attribute environment_id")
+	}
+
+	if v := got["name"].(datasourceschema.StringAttribute); v.Description != "name" || len(v.Validators) != 0 {
+		t.Fatalf("expected description to be retained and validators to be removed on attribute name")
+	}
+}
+
+func TestDataSourceItemsToTF(t *testing.T) {
+
+	type itemModel struct {
+		Id   pingonetypes.ResourceIDValue `tfsdk:"id"`
+		Name types.String                 `tfsdk:"name"`
+	}
+
+	attribute := Attr_DataSourceReturnItems(SchemaAttributeDescriptionFromMarkdown("items"), map[string]datasourceschema.Attribute{
+		"id": datasourceschema.StringAttribute{
+			Computed:   true,
+			CustomType: pingonetypes.ResourceIDType{},
+		},
+		"name": datasourceschema.StringAttribute{
+			Computed: true,
+		},
+	})
+
+	ctx := context.Background()
+
+	got, diags := DataSourceItemsToTF[itemModel](ctx, attribute.GetType(), nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if !got.IsNull() {
+		t.Fatalf("expected a null list when no items are provided")
+	}
+
+	got, diags = DataSourceItemsToTF(ctx, attribute.GetType(), []itemModel{
+		{
+			Id:   PingOneResourceIDToTF("9c052a8a-14be-44e4-8f07-2662569994ce"),
+			Name: types.StringValue("test"),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if len(got.Elements()) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(got.Elements()))
+	}
+
+	_, diags = DataSourceItemsToTF[itemModel](ctx, types.StringType, nil)
+	if !diags.HasError() {
+		t.Fatalf("expected an error diagnostic when the items attribute is not a list")
+	}
+}
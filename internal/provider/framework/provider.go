@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -29,7 +30,8 @@ import (
 
 // Ensure PingOneProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider = &pingOneProvider{}
+	_ provider.Provider              = &pingOneProvider{}
+	_ provider.ProviderWithFunctions = &pingOneProvider{}
 )
 
 // PingOneProvider defines the provider implementation.
@@ -422,6 +424,12 @@ func (p *pingOneProvider) DataSources(ctx context.Context) []func() datasource.D
 	return v
 }
 
+func (p *pingOneProvider) Functions(ctx context.Context) []func() function.Function {
+	v := make([]func() function.Function, 0)
+	v = append(v, davinci.Functions()...)
+	return v
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &pingOneProvider{
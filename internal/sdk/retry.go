@@ -162,6 +162,14 @@ func RetryWrapper(ctx context.Context, timeout time.Duration, f framework.SDKInt
 					return retry.NonRetryableError(err)
 				}
 
+			case *framework.RawRequestError:
+
+				errorModel, err1 = model.RemarshalErrorObj(t)
+				if err1 != nil {
+					tflog.Error(ctx, fmt.Sprintf("Cannot remarshal type %s", err1))
+					return retry.NonRetryableError(err)
+				}
+
 			case *url.Error:
 				tflog.Warn(ctx, fmt.Sprintf("Detected HTTP error %s", t.Err.Error()))
 
@@ -0,0 +1,285 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/authorize"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type APIServiceDataSource serviceClientType
+
+type apiServiceDataSourceModel struct {
+	Id                  pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId       pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	APIServiceId        pingonetypes.ResourceIDValue `tfsdk:"api_service_id"`
+	AccessControl       types.Object                 `tfsdk:"access_control"`
+	AuthorizationServer types.Object                 `tfsdk:"authorization_server"`
+	BaseURLs            types.Set                    `tfsdk:"base_urls"`
+	Directory           types.Object                 `tfsdk:"directory"`
+	Name                types.String                 `tfsdk:"name"`
+	PolicyId            pingonetypes.ResourceIDValue `tfsdk:"policy_id"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &APIServiceDataSource{}
+	_ datasource.DataSourceWithConfigure = &APIServiceDataSource{}
+)
+
+// New Object
+func NewAPIServiceDataSource() datasource.DataSource {
+	return &APIServiceDataSource{}
+}
+
+// Metadata
+func (r *APIServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorize_api_service"
+}
+
+// Schema
+func (r *APIServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"api_service_id",
+		"name",
+	}
+
+	apiServiceIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the API service to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the API service to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned API service attributes are taken from the pingone_authorize_api_service resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&APIServiceResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the API service from."),
+	)
+
+	attributes["api_service_id"] = schema.StringAttribute{
+		Description:         apiServiceIdDescription.Description,
+		MarkdownDescription: apiServiceIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("api_service_id"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to find a PingOne Authorize API service by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *APIServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *APIServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *apiServiceDataSourceModel
+
+	if r.Client == nil || r.Client.AuthorizeAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiService *authorize.APIServer
+
+	if !data.APIServiceId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.AuthorizeAPIClient.APIServersApi.ReadOneAPIServer(ctx, data.EnvironmentId.ValueString(), data.APIServiceId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneAPIServer",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&apiService,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return findAPIService(ctx, r.Client.AuthorizeAPIClient, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), func(apiServer authorize.APIServer) bool {
+					return strings.EqualFold(apiServer.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadAllAPIServers",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&apiService,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if apiService == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find API service from name",
+				fmt.Sprintf("The API service name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested API service: api_service_id or name argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(apiService)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *apiServiceDataSourceModel) toState(apiObject *authorize.APIServer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &APIServiceResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.APIServiceId = resourceModel.Id
+	p.AccessControl = resourceModel.AccessControl
+	p.AuthorizationServer = resourceModel.AuthorizationServer
+	p.BaseURLs = resourceModel.BaseURLs
+	p.Directory = resourceModel.Directory
+	p.Name = resourceModel.Name
+	p.PolicyId = resourceModel.PolicyId
+
+	return diags
+}
+
+func findAPIService(ctx context.Context, apiClient *authorize.APIClient, managementApiClient *management.APIClient, environmentID string, matcher func(authorize.APIServer) bool) (*authorize.APIServer, *http.Response, error) {
+	pagedIterator := apiClient.APIServersApi.ReadAllAPIServers(ctx, environmentID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, managementApiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if apiServers, ok := pageCursor.EntityArray.Embedded.GetApiServersOk(); ok {
+			for _, apiServer := range apiServers {
+				if matcher(apiServer) {
+					return &apiServer, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
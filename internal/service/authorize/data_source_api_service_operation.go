@@ -0,0 +1,288 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/authorize"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type APIServiceOperationDataSource serviceClientType
+
+type apiServiceOperationDataSourceModel struct {
+	Id                    pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId         pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	APIServiceId          pingonetypes.ResourceIDValue `tfsdk:"api_service_id"`
+	APIServiceOperationId pingonetypes.ResourceIDValue `tfsdk:"api_service_operation_id"`
+	AccessControl         types.Object                 `tfsdk:"access_control"`
+	Methods               types.Set                    `tfsdk:"methods"`
+	Paths                 types.Set                    `tfsdk:"paths"`
+	Name                  types.String                 `tfsdk:"name"`
+	PolicyId              pingonetypes.ResourceIDValue `tfsdk:"policy_id"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &APIServiceOperationDataSource{}
+	_ datasource.DataSourceWithConfigure = &APIServiceOperationDataSource{}
+)
+
+// New Object
+func NewAPIServiceOperationDataSource() datasource.DataSource {
+	return &APIServiceOperationDataSource{}
+}
+
+// Metadata
+func (r *APIServiceOperationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorize_api_service_operation"
+}
+
+// Schema
+func (r *APIServiceOperationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"api_service_operation_id",
+		"name",
+	}
+
+	apiServiceOperationIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the API service operation to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the API service operation to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned operation attributes are taken from the pingone_authorize_api_service_operation resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&APIServiceOperationResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the API service operation from."),
+	)
+
+	attributes["api_service_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the API service to retrieve the operation for."),
+	)
+
+	attributes["api_service_operation_id"] = schema.StringAttribute{
+		Description:         apiServiceOperationIdDescription.Description,
+		MarkdownDescription: apiServiceOperationIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("api_service_operation_id"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to find a PingOne Authorize API service operation by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *APIServiceOperationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *APIServiceOperationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *apiServiceOperationDataSourceModel
+
+	if r.Client == nil || r.Client.AuthorizeAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiServiceOperation *authorize.APIServerOperation
+
+	if !data.APIServiceOperationId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.AuthorizeAPIClient.APIServerOperationsApi.ReadOneAPIServerOperation(ctx, data.EnvironmentId.ValueString(), data.APIServiceId.ValueString(), data.APIServiceOperationId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneAPIServerOperation",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&apiServiceOperation,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return findAPIServiceOperation(ctx, r.Client.AuthorizeAPIClient, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.APIServiceId.ValueString(), func(apiServerOperation authorize.APIServerOperation) bool {
+					return strings.EqualFold(apiServerOperation.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadAllAPIServerOperations",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&apiServiceOperation,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if apiServiceOperation == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find API service operation from name",
+				fmt.Sprintf("The API service operation name %s for API service %s in environment %s cannot be found", data.Name.String(), data.APIServiceId.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested API service operation: api_service_operation_id or name argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(apiServiceOperation)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *apiServiceOperationDataSourceModel) toState(apiObject *authorize.APIServerOperation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &APIServiceOperationResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.APIServiceOperationId = resourceModel.Id
+	p.AccessControl = resourceModel.AccessControl
+	p.Methods = resourceModel.Methods
+	p.Paths = resourceModel.Paths
+	p.Name = resourceModel.Name
+	p.PolicyId = resourceModel.PolicyId
+
+	return diags
+}
+
+func findAPIServiceOperation(ctx context.Context, apiClient *authorize.APIClient, managementApiClient *management.APIClient, environmentID, apiServiceID string, matcher func(authorize.APIServerOperation) bool) (*authorize.APIServerOperation, *http.Response, error) {
+	pagedIterator := apiClient.APIServerOperationsApi.ReadAllAPIServerOperations(ctx, environmentID, apiServiceID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, managementApiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if apiServerOperations, ok := pageCursor.EntityArray.Embedded.GetOperationsOk(); ok {
+			for _, apiServerOperation := range apiServerOperations {
+				if matcher(apiServerOperation) {
+					return &apiServerOperation, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
@@ -0,0 +1,237 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/authorize"
+)
+
+func TestAccAPIServiceOperationDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_authorize_api_service_operation.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_api_service_operation.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIServiceOperation_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIServiceOperationDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_id", resourceFullName, "api_service_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_operation_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "access_control.scope.match_type", "ALL"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "access_control.scope.scopes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFullName, "methods.*", "GET"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFullName, "methods.*", "POST"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "paths.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "paths.*", map[string]string{
+						"pattern": "/test/1",
+						"type":    "EXACT",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "paths.*", map[string]string{
+						"pattern": "/test/{variable}/*",
+						"type":    "PARAMETER",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "policy_id", resourceFullName, "policy_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIServiceOperationDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_authorize_api_service_operation.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_api_service_operation.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIServiceOperation_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIServiceOperationDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_id", resourceFullName, "api_service_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_operation_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "paths.#", "2"),
+				),
+			},
+			{
+				Config: testAccAPIServiceOperationDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIServiceOperationDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIServiceOperation_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPIServiceOperationDataSourceConfig_NotFoundByName(resourceName, name),
+				ExpectError: regexp.MustCompile("Cannot find API service operation from name"),
+			},
+			{
+				Config:      testAccAPIServiceOperationDataSourceConfig_NotFoundByID(resourceName, name),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneAPIServerOperation`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccAPIServiceOperationDataSourceConfig_Operation(resourceName, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "pingone_resource_scope" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  resource_id    = pingone_resource.%[2]s.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_authorize_api_service_operation" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[2]s.id
+
+  name = "%[3]s"
+
+  access_control = {
+    scope = {
+      match_type = "ALL"
+      scopes = [
+        {
+          id = pingone_resource_scope.%[2]s.id
+        },
+      ]
+    }
+  }
+
+  methods = [
+    "GET",
+    "POST",
+  ]
+
+  paths = [
+    {
+      pattern = "/test/1"
+      type    = "EXACT"
+    },
+    {
+      pattern = "/test/{variable}/*"
+      type    = "PARAMETER"
+    }
+  ]
+}`, testAccAPIServiceDataSourceConfig_APIService(resourceName, name), resourceName, name)
+}
+
+func testAccAPIServiceOperationDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_authorize_api_service_operation" "%[3]s" {
+  environment_id           = data.pingone_environment.general_test.id
+  api_service_id           = pingone_authorize_api_service.%[3]s.id
+  api_service_operation_id = pingone_authorize_api_service_operation.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceOperationDataSourceConfig_Operation(resourceName, name), resourceName)
+}
+
+func testAccAPIServiceOperationDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_authorize_api_service_operation" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[3]s.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_authorize_api_service_operation.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceOperationDataSourceConfig_Operation(resourceName, name), resourceName, nameComparator)
+}
+
+func testAccAPIServiceOperationDataSourceConfig_NotFoundByName(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_authorize_api_service_operation" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[3]s.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceDataSourceConfig_APIService(resourceName, name), resourceName)
+}
+
+func testAccAPIServiceOperationDataSourceConfig_NotFoundByID(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_authorize_api_service_operation" "%[3]s" {
+  environment_id           = data.pingone_environment.general_test.id
+  api_service_id           = pingone_authorize_api_service.%[3]s.id
+  api_service_operation_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceDataSourceConfig_APIService(resourceName, name), resourceName)
+}
@@ -0,0 +1,177 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type APIServiceOperationsDataSource serviceClientType
+
+type APIServiceOperationsDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	APIServiceId  pingonetypes.ResourceIDValue `tfsdk:"api_service_id"`
+	Ids           types.List                   `tfsdk:"ids"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &APIServiceOperationsDataSource{}
+)
+
+// New Object
+func NewAPIServiceOperationsDataSource() datasource.DataSource {
+	return &APIServiceOperationsDataSource{}
+}
+
+// Metadata
+func (r *APIServiceOperationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorize_api_service_operations"
+}
+
+// Schema
+func (r *APIServiceOperationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve the IDs of multiple PingOne Authorize API service operations for an API service.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to select API service operations from."),
+			),
+
+			"api_service_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the API service to select operations for."),
+			),
+
+			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
+				"The list of resulting IDs of API service operations that have been successfully retrieved.",
+			)),
+		},
+	}
+}
+
+func (r *APIServiceOperationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *APIServiceOperationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *APIServiceOperationsDataSourceModel
+
+	if r.Client == nil || r.Client.AuthorizeAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var apiServiceOperationIDs []string
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := r.Client.AuthorizeAPIClient.APIServerOperationsApi.ReadAllAPIServerOperations(ctx, data.EnvironmentId.ValueString(), data.APIServiceId.ValueString()).Execute()
+
+			foundIDs := make([]string, 0)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if apiServerOperations, ok := pageCursor.EntityArray.Embedded.GetOperationsOk(); ok {
+					for _, apiServerOperation := range apiServerOperations {
+						foundIDs = append(foundIDs, apiServerOperation.GetId())
+					}
+				}
+			}
+
+			return foundIDs, initialHttpResponse, nil
+		},
+		"ReadAllAPIServerOperations",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&apiServiceOperationIDs,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(apiServiceOperationIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *APIServiceOperationsDataSourceModel) toState(apiServiceOperationIDs []string) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if apiServiceOperationIDs == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	p.Ids, d = framework.StringSliceToTF(apiServiceOperationIDs)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/authorize"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccAPIServiceOperationsDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_api_service_operations.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIServiceOperation_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIServiceOperationsDataSourceConfig_ByAll(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_id", fmt.Sprintf("pingone_authorize_api_service.%s", resourceName), "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_authorize_api_service_operation.%s-1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_authorize_api_service_operation.%s-2", resourceName), "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIServiceOperationsDataSourceConfig_ByAll(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+resource "pingone_authorize_api_service_operation" "%[3]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[3]s.id
+
+  name = "%[4]s-1"
+
+  paths = [
+    {
+      pattern = "/test/1"
+      type    = "EXACT"
+    }
+  ]
+}
+
+resource "pingone_authorize_api_service_operation" "%[3]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[3]s.id
+
+  name = "%[4]s-2"
+
+  paths = [
+    {
+      pattern = "/test/2"
+      type    = "EXACT"
+    }
+  ]
+}
+
+data "pingone_authorize_api_service_operations" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[3]s.id
+
+  depends_on = [
+    pingone_authorize_api_service_operation.%[3]s-1,
+    pingone_authorize_api_service_operation.%[3]s-2,
+  ]
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceDataSourceConfig_APIService(resourceName, name), resourceName, name)
+}
@@ -0,0 +1,211 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/authorize"
+)
+
+func TestAccAPIServiceDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_authorize_api_service.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_api_service.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIService_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIServiceDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "access_control.custom.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "base_urls.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFullName, "base_urls.*", fmt.Sprintf("https://api.bxretail.org/%s", name)),
+					resource.TestCheckTypeSetElemAttr(dataSourceFullName, "base_urls.*", fmt.Sprintf("https://api.bxretail.org/%s/1", name)),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "authorization_server.resource_id", resourceFullName, "authorization_server.resource_id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "authorization_server.type", "PINGONE_SSO"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "directory.type", "PINGONE_SSO"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "policy_id", resourceFullName, "policy_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIServiceDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_authorize_api_service.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_api_service.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIService_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIServiceDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "api_service_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "authorization_server.resource_id", resourceFullName, "authorization_server.resource_id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "base_urls.#", "2"),
+				),
+			},
+			{
+				Config: testAccAPIServiceDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIServiceDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIService_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPIServiceDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find API service from name"),
+			},
+			{
+				Config:      testAccAPIServiceDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneAPIServer`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccAPIServiceDataSourceConfig_APIService(resourceName, name string) string {
+	return fmt.Sprintf(`
+resource "pingone_resource" "%[1]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[2]s"
+
+  audience                      = "%[2]s"
+  access_token_validity_seconds = 3600
+}
+
+resource "pingone_authorize_api_service" "%[1]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[2]s"
+
+  access_control = {
+    custom = {
+      enabled = true
+    }
+  }
+
+  base_urls = [
+    "https://api.bxretail.org/%[2]s",
+    "https://api.bxretail.org/%[2]s/1"
+  ]
+
+  authorization_server = {
+    resource_id = pingone_resource.%[1]s.id
+    type        = "PINGONE_SSO"
+  }
+
+  directory = {
+    type = "PINGONE_SSO"
+  }
+}`, resourceName, name)
+}
+
+func testAccAPIServiceDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_authorize_api_service" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = pingone_authorize_api_service.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceDataSourceConfig_APIService(resourceName, name), resourceName)
+}
+
+func testAccAPIServiceDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_authorize_api_service" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_authorize_api_service.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccAPIServiceDataSourceConfig_APIService(resourceName, name), resourceName, nameComparator)
+}
+
+func testAccAPIServiceDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_authorize_api_service" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccAPIServiceDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_authorize_api_service" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  api_service_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/authorize"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccAPIServicesDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_api_services.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.APIService_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIServicesDataSourceConfig_ByAll(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "ids.#", regexp.MustCompile(`^([2-9]|[1-9][0-9]+)$`)),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_authorize_api_service.%s-1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_authorize_api_service.%s-2", resourceName), "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIServicesDataSourceConfig_ByAll(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_resource" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+
+  audience                      = "%[3]s"
+  access_token_validity_seconds = 3600
+}
+
+resource "pingone_authorize_api_service" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-1"
+
+  base_urls = [
+    "https://api.bxretail.org/%[3]s/1",
+  ]
+
+  authorization_server = {
+    resource_id = pingone_resource.%[2]s.id
+    type        = "PINGONE_SSO"
+  }
+}
+
+resource "pingone_authorize_api_service" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-2"
+
+  base_urls = [
+    "https://api.bxretail.org/%[3]s/2",
+  ]
+
+  authorization_server = {
+    resource_id = pingone_resource.%[2]s.id
+    type        = "PINGONE_SSO"
+  }
+}
+
+data "pingone_authorize_api_services" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  depends_on = [
+    pingone_authorize_api_service.%[2]s-1,
+    pingone_authorize_api_service.%[2]s-2,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
@@ -0,0 +1,277 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/authorize"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type ApplicationRoleDataSource serviceClientType
+
+type applicationRoleDataSourceModel struct {
+	Id                pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId     pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	ApplicationRoleId pingonetypes.ResourceIDValue `tfsdk:"application_role_id"`
+	Name              types.String                 `tfsdk:"name"`
+	Description       types.String                 `tfsdk:"description"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &ApplicationRoleDataSource{}
+	_ datasource.DataSourceWithConfigure = &ApplicationRoleDataSource{}
+)
+
+// New Object
+func NewApplicationRoleDataSource() datasource.DataSource {
+	return &ApplicationRoleDataSource{}
+}
+
+// Metadata
+func (r *ApplicationRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorize_application_role"
+}
+
+// Schema
+func (r *ApplicationRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"application_role_id",
+		"name",
+	}
+
+	applicationRoleIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the application role to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the application role to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned application role attributes are taken from the pingone_authorize_application_role resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&ApplicationRoleResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the application role from."),
+	)
+
+	attributes["application_role_id"] = schema.StringAttribute{
+		Description:         applicationRoleIdDescription.Description,
+		MarkdownDescription: applicationRoleIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("application_role_id"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to find a PingOne Authorize application role by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *ApplicationRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *ApplicationRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *applicationRoleDataSourceModel
+
+	if r.Client == nil || r.Client.AuthorizeAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applicationRole *authorize.ApplicationRole
+
+	if !data.ApplicationRoleId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.AuthorizeAPIClient.ApplicationRolesApi.ReadOneApplicationRole(ctx, data.EnvironmentId.ValueString(), data.ApplicationRoleId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneApplicationRole",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&applicationRole,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return findApplicationRole(ctx, r.Client.AuthorizeAPIClient, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), func(applicationRole authorize.ApplicationRole) bool {
+					return strings.EqualFold(applicationRole.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadApplicationRoles",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&applicationRole,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if applicationRole == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find application role from name",
+				fmt.Sprintf("The application role name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested application role: application_role_id or name argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(applicationRole)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *applicationRoleDataSourceModel) toState(apiObject *authorize.ApplicationRole) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &ApplicationRoleResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.ApplicationRoleId = resourceModel.Id
+	p.Name = resourceModel.Name
+	p.Description = resourceModel.Description
+
+	return diags
+}
+
+func findApplicationRole(ctx context.Context, apiClient *authorize.APIClient, managementApiClient *management.APIClient, environmentID string, matcher func(authorize.ApplicationRole) bool) (*authorize.ApplicationRole, *http.Response, error) {
+	pagedIterator := apiClient.ApplicationRolesApi.ReadApplicationRoles(ctx, environmentID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, managementApiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if applicationRoles, ok := pageCursor.EntityArray.Embedded.GetRolesOk(); ok {
+			for _, applicationRole := range applicationRoles {
+				if matcher(applicationRole) {
+					return &applicationRole, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
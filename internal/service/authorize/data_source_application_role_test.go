@@ -0,0 +1,173 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/authorize"
+)
+
+func TestAccApplicationRoleDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_authorize_application_role.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_application_role.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.ApplicationRole_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRoleDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "application_role_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceFullName, "description", "Test application role"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationRoleDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_authorize_application_role.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_application_role.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.ApplicationRole_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRoleDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "application_role_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceFullName, "description", "Test application role"),
+				),
+			},
+			{
+				Config: testAccApplicationRoleDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationRoleDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.ApplicationRole_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationRoleDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find application role from name"),
+			},
+			{
+				Config:      testAccApplicationRoleDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneApplicationRole`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccApplicationRoleDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_authorize_application_role" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+  description    = "Test application role"
+}
+
+data "pingone_authorize_application_role" "%[2]s" {
+  environment_id      = data.pingone_environment.general_test.id
+  application_role_id = pingone_authorize_application_role.%[2]s.id
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccApplicationRoleDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_authorize_application_role" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+  description    = "Test application role"
+}
+
+data "pingone_authorize_application_role" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_authorize_application_role.%[2]s]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, nameComparator)
+}
+
+func testAccApplicationRoleDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_authorize_application_role" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccApplicationRoleDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_authorize_application_role" "%[2]s" {
+  environment_id      = data.pingone_environment.general_test.id
+  application_role_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package authorize_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/authorize"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccApplicationRolesDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_authorize_application_roles.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             authorize.ApplicationRole_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRolesDataSourceConfig_ByAll(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "ids.#", regexp.MustCompile(`^([2-9]|[1-9][0-9]+)$`)),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_authorize_application_role.%s-1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_authorize_application_role.%s-2", resourceName), "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationRolesDataSourceConfig_ByAll(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_authorize_application_role" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s-1"
+}
+
+resource "pingone_authorize_application_role" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s-2"
+}
+
+data "pingone_authorize_application_roles" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  depends_on = [
+    pingone_authorize_application_role.%[2]s-1,
+    pingone_authorize_application_role.%[2]s-2,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
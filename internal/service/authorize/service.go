@@ -27,7 +27,14 @@ func Resources() []func() resource.Resource {
 }
 
 func DataSources() []func() datasource.DataSource {
-	dataSources := []func() datasource.DataSource{}
+	dataSources := []func() datasource.DataSource{
+		NewAPIServiceDataSource,
+		NewAPIServiceOperationDataSource,
+		NewAPIServiceOperationsDataSource,
+		NewAPIServicesDataSource,
+		NewApplicationRoleDataSource,
+		NewApplicationRolesDataSource,
+	}
 	dataSources = append(dataSources, BetaDataSources()...)
 
 	return dataSources
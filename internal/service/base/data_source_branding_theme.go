@@ -0,0 +1,350 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type BrandingThemeDataSource serviceClientType
+
+type brandingThemeDataSourceModel struct {
+	Id                   pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId        pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	BrandingThemeId      pingonetypes.ResourceIDValue `tfsdk:"branding_theme_id"`
+	Name                 types.String                 `tfsdk:"name"`
+	Template             types.String                 `tfsdk:"template"`
+	Default              types.Bool                   `tfsdk:"default"`
+	Logo                 types.Object                 `tfsdk:"logo"`
+	BackgroundImage      types.Object                 `tfsdk:"background_image"`
+	BackgroundColor      types.String                 `tfsdk:"background_color"`
+	UseDefaultBackground types.Bool                   `tfsdk:"use_default_background"`
+	BodyTextColor        types.String                 `tfsdk:"body_text_color"`
+	ButtonColor          types.String                 `tfsdk:"button_color"`
+	ButtonTextColor      types.String                 `tfsdk:"button_text_color"`
+	CardColor            types.String                 `tfsdk:"card_color"`
+	FooterText           types.String                 `tfsdk:"footer_text"`
+	HeadingTextColor     types.String                 `tfsdk:"heading_text_color"`
+	LinkTextColor        types.String                 `tfsdk:"link_text_color"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &BrandingThemeDataSource{}
+	_ datasource.DataSourceWithConfigure = &BrandingThemeDataSource{}
+)
+
+// New Object
+func NewBrandingThemeDataSource() datasource.DataSource {
+	return &BrandingThemeDataSource{}
+}
+
+// Metadata
+func (r *BrandingThemeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branding_theme"
+}
+
+// Schema
+func (r *BrandingThemeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"branding_theme_id",
+		"name",
+		"default",
+	}
+
+	brandingThemeIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the branding theme to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the branding theme to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	defaultDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"Set value to `true` to return the environment's default branding theme. There is only one default theme per environment.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned theme attributes are taken from the pingone_branding_theme resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&BrandingThemeResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the branding theme from."),
+	)
+
+	attributes["branding_theme_id"] = schema.StringAttribute{
+		Description:         brandingThemeIdDescription.Description,
+		MarkdownDescription: brandingThemeIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("branding_theme_id"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	attributes["default"] = schema.BoolAttribute{
+		Description:         defaultDescription.Description,
+		MarkdownDescription: defaultDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.Bool{
+			boolvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("branding_theme_id"),
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve the default PingOne branding theme or to find a PingOne branding theme by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *BrandingThemeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *BrandingThemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *brandingThemeDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var brandingTheme *management.BrandingTheme
+
+	if !data.BrandingThemeId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.ManagementAPIClient.BrandingThemesApi.ReadOneBrandingTheme(ctx, data.EnvironmentId.ValueString(), data.BrandingThemeId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneBrandingTheme",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&brandingTheme,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return findBrandingTheme(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), func(theme management.BrandingTheme) bool {
+					configuration := theme.GetConfiguration()
+					return strings.EqualFold(configuration.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadBrandingThemes",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&brandingTheme,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if brandingTheme == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find branding theme from name",
+				fmt.Sprintf("The branding theme name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else if data.Default.ValueBool() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return findBrandingTheme(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), func(theme management.BrandingTheme) bool {
+					return theme.GetDefault()
+				})
+			},
+			"ReadBrandingThemes",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&brandingTheme,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if brandingTheme == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find default branding theme",
+				fmt.Sprintf("The default branding theme for environment %s cannot be found", data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested branding theme: branding_theme_id, name, or default argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(brandingTheme)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *brandingThemeDataSourceModel) toState(apiObject *management.BrandingTheme) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &brandingThemeResourceModelV1{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.EnvironmentId = resourceModel.EnvironmentId
+	p.BrandingThemeId = resourceModel.Id
+	p.Name = resourceModel.Name
+	p.Template = resourceModel.Template
+	p.Default = resourceModel.Default
+	p.Logo = resourceModel.Logo
+	p.BackgroundImage = resourceModel.BackgroundImage
+	p.BackgroundColor = resourceModel.BackgroundColor
+	p.UseDefaultBackground = resourceModel.UseDefaultBackground
+	p.BodyTextColor = resourceModel.BodyTextColor
+	p.ButtonColor = resourceModel.ButtonColor
+	p.ButtonTextColor = resourceModel.ButtonTextColor
+	p.CardColor = resourceModel.CardColor
+	p.FooterText = resourceModel.FooterText
+	p.HeadingTextColor = resourceModel.HeadingTextColor
+	p.LinkTextColor = resourceModel.LinkTextColor
+
+	return diags
+}
+
+func findBrandingTheme(ctx context.Context, apiClient *management.APIClient, environmentID string, matcher func(management.BrandingTheme) bool) (*management.BrandingTheme, *http.Response, error) {
+	pagedIterator := apiClient.BrandingThemesApi.ReadBrandingThemes(ctx, environmentID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if themes, ok := pageCursor.EntityArray.Embedded.GetThemesOk(); ok {
+			for _, theme := range themes {
+				if matcher(theme) {
+					return &theme, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
@@ -0,0 +1,255 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+)
+
+func TestAccBrandingThemeDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_branding_theme.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_branding_theme.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.BrandingTheme_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingThemeDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "branding_theme_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "template", "split"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "logo"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "background_image"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "background_color", "#FF00F0"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "use_default_background", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "body_text_color", "#8620FF"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "button_color", "#0CFFFB"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "button_text_color", "#FF6C6C"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "card_color", "#0FFF39"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "footer_text", "My footer text"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "heading_text_color", "#FF0005"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "link_text_color", "#8A7F06"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrandingThemeDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_branding_theme.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_branding_theme.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.BrandingTheme_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingThemeDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "branding_theme_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "template", "split"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+				),
+			},
+			{
+				Config: testAccBrandingThemeDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrandingThemeDataSource_ByDefault(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_branding_theme.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_branding_theme.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.BrandingTheme_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingThemeDataSourceConfig_ByDefault(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "branding_theme_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrandingThemeDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.BrandingTheme_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBrandingThemeDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find branding theme from name"),
+			},
+			{
+				Config:      testAccBrandingThemeDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneBrandingTheme`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccBrandingThemeDataSourceConfig_Theme(environmentID, resourceName, name string) string {
+	return fmt.Sprintf(`
+resource "pingone_branding_theme" "%[2]s" {
+  environment_id = %[1]s
+
+  name     = "%[3]s"
+  template = "split"
+
+  background_color   = "#FF00F0"
+  button_text_color  = "#FF6C6C"
+  heading_text_color = "#FF0005"
+  card_color         = "#0FFF39"
+  body_text_color    = "#8620FF"
+  link_text_color    = "#8A7F06"
+  button_color       = "#0CFFFB"
+  footer_text        = "My footer text"
+}`, environmentID, resourceName, name)
+}
+
+func testAccBrandingThemeDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_branding_theme" "%[3]s" {
+  environment_id    = data.pingone_environment.general_test.id
+  branding_theme_id = pingone_branding_theme.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccBrandingThemeDataSourceConfig_Theme("data.pingone_environment.general_test.id", resourceName, name), resourceName)
+}
+
+func testAccBrandingThemeDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_branding_theme" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_branding_theme.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccBrandingThemeDataSourceConfig_Theme("data.pingone_environment.general_test.id", resourceName, name), resourceName, nameComparator)
+}
+
+func testAccBrandingThemeDataSourceConfig_ByDefault(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+resource "pingone_branding_theme_default" "%[4]s" {
+  environment_id    = pingone_environment.%[3]s.id
+  branding_theme_id = pingone_branding_theme.%[4]s.id
+}
+
+data "pingone_branding_theme" "%[4]s" {
+  environment_id = pingone_environment.%[3]s.id
+  default        = true
+
+  depends_on = [pingone_branding_theme_default.%[4]s]
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), testAccBrandingThemeDataSourceConfig_Theme(fmt.Sprintf("pingone_environment.%s.id", environmentName), resourceName, name), environmentName, resourceName)
+}
+
+func testAccBrandingThemeDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_branding_theme" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccBrandingThemeDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_branding_theme" "%[2]s" {
+  environment_id    = data.pingone_environment.general_test.id
+  branding_theme_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
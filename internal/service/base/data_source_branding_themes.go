@@ -0,0 +1,192 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type BrandingThemesDataSource serviceClientType
+
+type BrandingThemesDataSourceModel struct {
+	Id                     pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId          pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	DefaultBrandingThemeId pingonetypes.ResourceIDValue `tfsdk:"default_branding_theme_id"`
+	Ids                    types.List                   `tfsdk:"ids"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &BrandingThemesDataSource{}
+)
+
+// New Object
+func NewBrandingThemesDataSource() datasource.DataSource {
+	return &BrandingThemesDataSource{}
+}
+
+// Metadata
+func (r *BrandingThemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branding_themes"
+}
+
+// Schema
+func (r *BrandingThemesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve the IDs of multiple PingOne branding themes in an environment, including the ID of the environment's default branding theme.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to select branding themes from."),
+			),
+
+			"default_branding_theme_id": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the branding theme that is the environment's default branding configuration.  The value is null if no custom branding theme is set as the environment default.").Description,
+				Computed:    true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+
+			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
+				"The list of resulting IDs of branding themes that have been successfully retrieved.",
+			)),
+		},
+	}
+}
+
+func (r *BrandingThemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *BrandingThemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *BrandingThemesDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var brandingThemes []management.BrandingTheme
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := r.Client.ManagementAPIClient.BrandingThemesApi.ReadBrandingThemes(ctx, data.EnvironmentId.ValueString()).Execute()
+
+			brandingThemes := make([]management.BrandingTheme, 0)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Themes != nil {
+					brandingThemes = append(brandingThemes, pageCursor.EntityArray.Embedded.GetThemes()...)
+				}
+			}
+
+			return brandingThemes, initialHttpResponse, nil
+		},
+		"ReadBrandingThemes",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&brandingThemes,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(brandingThemes)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *BrandingThemesDataSourceModel) toState(apiObject []management.BrandingTheme) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	var d diag.Diagnostics
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	brandingThemeIDs := make([]string, 0, len(apiObject))
+	p.DefaultBrandingThemeId = pingonetypes.NewResourceIDNull()
+
+	for _, brandingTheme := range apiObject {
+		brandingThemeIDs = append(brandingThemeIDs, brandingTheme.GetId())
+
+		if brandingTheme.GetDefault() {
+			p.DefaultBrandingThemeId = framework.PingOneResourceIDToTF(brandingTheme.GetId())
+		}
+	}
+
+	p.Ids, d = framework.StringSliceToTF(brandingThemeIDs)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,102 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccBrandingThemesDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_branding_themes.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.BrandingTheme_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingThemesDataSourceConfig_ByAll(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_branding_theme.%s-1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_branding_theme.%s-2", resourceName), "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "default_branding_theme_id", fmt.Sprintf("pingone_branding_theme.%s-2", resourceName), "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBrandingThemesDataSourceConfig_ByAll(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_branding_theme" "%[3]s-1" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name     = "%[4]s-1"
+  template = "split"
+
+  background_color   = "#FF00F0"
+  button_text_color  = "#FF6C6C"
+  heading_text_color = "#FF0005"
+  card_color         = "#0FFF39"
+  body_text_color    = "#8620FF"
+  link_text_color    = "#8A7F06"
+  button_color       = "#0CFFFB"
+}
+
+resource "pingone_branding_theme" "%[3]s-2" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name     = "%[4]s-2"
+  template = "slate"
+
+  background_color   = "#FF00F0"
+  button_text_color  = "#FF6C6C"
+  heading_text_color = "#FF0005"
+  card_color         = "#0FFF39"
+  body_text_color    = "#8620FF"
+  link_text_color    = "#8A7F06"
+  button_color       = "#0CFFFB"
+}
+
+resource "pingone_branding_theme_default" "%[3]s" {
+  environment_id    = pingone_environment.%[2]s.id
+  branding_theme_id = pingone_branding_theme.%[3]s-2.id
+}
+
+data "pingone_branding_themes" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  depends_on = [
+    pingone_branding_theme.%[3]s-1,
+    pingone_branding_theme_default.%[3]s,
+  ]
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
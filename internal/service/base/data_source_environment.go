@@ -377,7 +377,7 @@ func (r *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 func (p *EnvironmentDataSourceModel) toState(environmentApiObject *management.Environment, servicesApiObject *management.BillOfMaterials) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if environmentApiObject == nil || servicesApiObject == nil {
+	if environmentApiObject == nil {
 		diags.AddError(
 			"Data object missing",
 			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
@@ -403,6 +403,14 @@ func (p *EnvironmentDataSourceModel) toState(environmentApiObject *management.En
 		p.OrganizationId = pingonetypes.NewResourceIDNull()
 	}
 
+	// The bill of materials is not returned when environments are listed
+	if servicesApiObject == nil {
+		p.Solution = types.StringNull()
+		p.Services = types.SetNull(types.ObjectType{AttrTypes: environmentServiceTFObjectTypes})
+
+		return diags
+	}
+
 	p.Solution = framework.EnumOkToTF(servicesApiObject.GetSolutionTypeOk())
 
 	services, d := toStateEnvironmentServices(servicesApiObject.GetProducts())
@@ -8,9 +8,11 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/patrickcping/pingone-go-sdk-v2/management"
 	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
@@ -22,9 +24,11 @@ import (
 type EnvironmentsDataSource serviceClientType
 
 type EnvironmentsDataSourceModel struct {
-	Id         pingonetypes.ResourceIDValue `tfsdk:"id"`
-	ScimFilter types.String                 `tfsdk:"scim_filter"`
-	Ids        types.List                   `tfsdk:"ids"`
+	Id             pingonetypes.ResourceIDValue `tfsdk:"id"`
+	ScimFilter     types.String                 `tfsdk:"scim_filter"`
+	Ids            types.List                   `tfsdk:"ids"`
+	IncludeDetails types.Bool                   `tfsdk:"include_details"`
+	Items          types.List                   `tfsdk:"items"`
 }
 
 // Framework interfaces
@@ -49,6 +53,29 @@ func (r *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.Sche
 		"A SCIM filter to apply to the environment selection.  A SCIM filter offers the greatest flexibility in filtering environments.  SCIM operators can be used in the following ways: `sw` (starts with) supports the `name` attribute; `eq` (equal to) supports the `id`, `organization.id`, `license.id` attributes; `and` (logical AND) can be used to aggregate conditions.  For example, `(name sw \"TEST-\") AND (license.id eq \"${var.license_id}\")`",
 	)
 
+	// The returned environment attributes are taken from the pingone_environment data source schema, so each item has the same shape as the singular data source
+	environmentSchemaResp := datasource.SchemaResponse{}
+	(&EnvironmentDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &environmentSchemaResp)
+	resp.Diagnostics.Append(environmentSchemaResp.Diagnostics...)
+
+	itemAttributes, d := framework.DataSourceComputedAttributesFromDataSourceAttributes(environmentSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemAttributes["environment_id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	itemAttributes["name"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The name of the environment.").Description,
+		Computed:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Datasource to retrieve multiple PingOne environments.",
@@ -65,6 +92,14 @@ func (r *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.Sche
 			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
 				"The list of resulting IDs of environments that have been successfully retrieved and filtered.",
 			)),
+
+			"include_details": framework.Attr_DataSourceIncludeDetails(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A boolean that specifies whether the full details of each environment should be returned in the `items` attribute.  The details are taken from the same paged list response used to populate `ids`, so no additional API calls are made.",
+			)),
+
+			"items": framework.Attr_DataSourceReturnItems(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A list of objects that describe the environments that have been successfully retrieved and filtered, with the same attributes as the `pingone_environment` data source.  The environment's bill of materials is not returned when environments are listed, so `solution` and `services` are not populated.  Only populated when `include_details` is set to `true`.",
+			), itemAttributes),
 		},
 	}
 }
@@ -147,12 +182,18 @@ func (r *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	itemsType, d := req.Config.Schema.TypeAtPath(ctx, path.Root("items"))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(data.toState(environments)...)
+	resp.Diagnostics.Append(data.toState(ctx, environments, itemsType)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (p *EnvironmentsDataSourceModel) toState(environments []management.Environment) diag.Diagnostics {
+func (p *EnvironmentsDataSourceModel) toState(ctx context.Context, environments []management.Environment, itemsType attr.Type) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if environments == nil {
@@ -164,9 +205,25 @@ func (p *EnvironmentsDataSourceModel) toState(environments []management.Environm
 		return diags
 	}
 
+	var items []EnvironmentDataSourceModel
+
+	if p.IncludeDetails.ValueBool() {
+		items = make([]EnvironmentDataSourceModel, 0, len(environments))
+	}
+
 	list := make([]string, 0)
 	for _, item := range environments {
 		list = append(list, item.GetId())
+
+		if items != nil {
+			environment := EnvironmentDataSourceModel{}
+			diags.Append(environment.toState(&item, nil)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			items = append(items, environment)
+		}
 	}
 
 	var d diag.Diagnostics
@@ -178,5 +235,8 @@ func (p *EnvironmentsDataSourceModel) toState(environments []management.Environm
 	p.Ids, d = framework.StringSliceToTF(list)
 	diags.Append(d...)
 
+	p.Items, d = framework.DataSourceItemsToTF(ctx, itemsType, items)
+	diags.Append(d...)
+
 	return diags
 }
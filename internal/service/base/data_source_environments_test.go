@@ -43,6 +43,50 @@ func TestAccEnvironmentsDataSource_BySCIMFilter(t *testing.T) {
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.0", verify.P1ResourceIDRegexpFullString),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.1", verify.P1ResourceIDRegexpFullString),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.2", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEnvironmentsDataSource_IncludeDetails(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_environments.%s", resourceName)
+	environmentResourceFullName := fmt.Sprintf("pingone_environment.%s", resourceName)
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             baselegacysdk.Environment_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentsDataSourceConfig_IncludeDetails(resourceName, name, licenseID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.id", environmentResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.environment_id", environmentResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.name", environmentResourceFullName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.description", environmentResourceFullName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.type", environmentResourceFullName, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.region", environmentResourceFullName, "region"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.license_id", environmentResourceFullName, "license_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.organization_id", environmentResourceFullName, "organization_id"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.0.solution"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.0.services.#"),
 				),
 			},
 		},
@@ -128,6 +172,30 @@ data "pingone_environments" "%[1]s" {
 `, resourceName, name, licenseID, filter)
 }
 
+func testAccEnvironmentsDataSourceConfig_IncludeDetails(resourceName, name, licenseID string) string {
+	return fmt.Sprintf(`
+
+resource "pingone_environment" "%[1]s" {
+  name        = "%[2]s"
+  description = "Test environment"
+  type        = "SANDBOX"
+  license_id  = "%[3]s"
+
+  services = [
+    {
+      type = "SSO"
+    }
+  ]
+}
+
+data "pingone_environments" "%[1]s" {
+
+  scim_filter     = "id eq \"${pingone_environment.%[1]s.id}\""
+  include_details = true
+}
+`, resourceName, name, licenseID)
+}
+
 func testAccEnvironmentsDataSourceConfig_NotFound(resourceName, filter string) string {
 	return fmt.Sprintf(`
 data "pingone_environments" "%[1]s" {
@@ -0,0 +1,303 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type FormDataSource serviceClientType
+
+type formDataSourceModel struct {
+	Id                          pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId               pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	FormId                      pingonetypes.ResourceIDValue `tfsdk:"form_id"`
+	Name                        types.String                 `tfsdk:"name"`
+	Description                 types.String                 `tfsdk:"description"`
+	Category                    types.String                 `tfsdk:"category"`
+	Cols                        types.Int32                  `tfsdk:"cols"`
+	Components                  types.Object                 `tfsdk:"components"`
+	FieldTypes                  types.Set                    `tfsdk:"field_types"`
+	LanguageBundle              types.Map                    `tfsdk:"language_bundle"`
+	MarkOptional                types.Bool                   `tfsdk:"mark_optional"`
+	MarkRequired                types.Bool                   `tfsdk:"mark_required"`
+	PasswordAutoCompleteEnabled types.Bool                   `tfsdk:"password_auto_complete_enabled"`
+	TextAutoCompleteEnabled     types.Bool                   `tfsdk:"text_auto_complete_enabled"`
+	TranslationMethod           types.String                 `tfsdk:"translation_method"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &FormDataSource{}
+	_ datasource.DataSourceWithConfigure = &FormDataSource{}
+)
+
+// New Object
+func NewFormDataSource() datasource.DataSource {
+	return &FormDataSource{}
+}
+
+// Metadata
+func (r *FormDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_form"
+}
+
+// Schema
+func (r *FormDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"form_id",
+		"name",
+	}
+
+	formIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the form to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the form to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned form attributes are taken from the pingone_form resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&FormResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the form from."),
+	)
+
+	attributes["form_id"] = schema.StringAttribute{
+		Description:         formIdDescription.Description,
+		MarkdownDescription: formIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("form_id"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to find a PingOne form by its ID or name, including the form's components and fields.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *FormDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *FormDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *formDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var formID string
+
+	if !data.FormId.IsNull() {
+		formID = data.FormId.ValueString()
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		var form *management.Form
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return findForm(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), func(form management.Form) bool {
+					return strings.EqualFold(form.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadAllForms",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&form,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if form == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find form from name",
+				fmt.Sprintf("The form name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+		formID = form.GetId()
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested form: form_id or name argument must be set.",
+		)
+		return
+	}
+
+	// The list response does not include the form components, so the form is always read individually
+	var form *management.Form
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.ManagementAPIClient.FormManagementApi.ReadForm(ctx, data.EnvironmentId.ValueString(), formID).Include(management.ENUMFORMSINCLUDEPARAMETER_COMPONENTS).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadForm",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&form,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(form)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *formDataSourceModel) toState(apiObject *management.Form) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &formResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.EnvironmentId = resourceModel.EnvironmentId
+	p.FormId = resourceModel.Id
+	p.Name = resourceModel.Name
+	p.Description = resourceModel.Description
+	p.Category = resourceModel.Category
+	p.Cols = resourceModel.Cols
+	p.Components = resourceModel.Components
+	p.FieldTypes = resourceModel.FieldTypes
+	p.LanguageBundle = resourceModel.LanguageBundle
+	p.MarkOptional = resourceModel.MarkOptional
+	p.MarkRequired = resourceModel.MarkRequired
+	p.PasswordAutoCompleteEnabled = resourceModel.PasswordAutoCompleteEnabled
+	p.TextAutoCompleteEnabled = resourceModel.TextAutoCompleteEnabled
+	p.TranslationMethod = resourceModel.TranslationMethod
+
+	return diags
+}
+
+func findForm(ctx context.Context, apiClient *management.APIClient, environmentID string, matcher func(management.Form) bool) (*management.Form, *http.Response, error) {
+	pagedIterator := apiClient.FormManagementApi.ReadAllForms(ctx, environmentID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if forms, ok := pageCursor.EntityArray.Embedded.GetFormsOk(); ok {
+			for _, form := range forms {
+				if matcher(form) {
+					return &form, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
@@ -0,0 +1,224 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+)
+
+func TestAccFormDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_form.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_form.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.Form_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFormDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "form_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceFullName, "description", "Test form"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "category", "CUSTOM"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "cols", "4"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.0.type", "TEXT"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.0.key", "text-field"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.0.position.row", "0"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.0.position.col", "0"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.0.validation.type", "NONE"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.1.type", "SUBMIT_BUTTON"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "field_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFullName, "field_types.*", "TEXT"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFullName, "field_types.*", "SUBMIT_BUTTON"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "mark_optional", resourceFullName, "mark_optional"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "mark_required", resourceFullName, "mark_required"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "translation_method", resourceFullName, "translation_method"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFormDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_form.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_form.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.Form_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFormDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "form_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "components.fields.0.key", "text-field"),
+				),
+			},
+			{
+				Config: testAccFormDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFormDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.Form_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFormDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find form from name"),
+			},
+			{
+				Config:      testAccFormDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadForm`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccFormDataSourceConfig_Form(resourceName, name string) string {
+	return fmt.Sprintf(`
+resource "pingone_form" "%[1]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name        = "%[2]s"
+  description = "Test form"
+
+  cols = 4
+
+  components = {
+    fields = [
+      {
+        type = "TEXT"
+
+        position = {
+          row = 0
+          col = 0
+        }
+
+        label = "[{\"type\":\"paragraph\",\"children\":[{\"text\":\"Placeholder\"}]}]"
+
+        key = "text-field"
+
+        validation = {
+          type = "NONE"
+        }
+      },
+      {
+        type = "SUBMIT_BUTTON"
+
+        position = {
+          row = 1
+          col = 0
+        }
+
+        label = "[{\"type\":\"paragraph\",\"children\":[{\"text\":\"\"},{\"type\":\"i18n\",\"key\":\"button.text\",\"defaultTranslation\":\"Submit\",\"inline\":true,\"children\":[{\"text\":\"\"}]},{\"text\":\"\"}]}]"
+      }
+    ]
+  }
+}`, resourceName, name)
+}
+
+func testAccFormDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_form" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  form_id        = pingone_form.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccFormDataSourceConfig_Form(resourceName, name), resourceName)
+}
+
+func testAccFormDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_form" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_form.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccFormDataSourceConfig_Form(resourceName, name), resourceName, nameComparator)
+}
+
+func testAccFormDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_form" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccFormDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_form" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  form_id        = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
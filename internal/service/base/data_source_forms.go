@@ -0,0 +1,294 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type FormsDataSource serviceClientType
+
+type FormsDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	Ids           types.List                   `tfsdk:"ids"`
+	Forms         types.List                   `tfsdk:"forms"`
+}
+
+var (
+	formsFormTFObjectTypes = map[string]attr.Type{
+		"id":                             pingonetypes.ResourceIDType{},
+		"name":                           types.StringType,
+		"description":                    types.StringType,
+		"category":                       types.StringType,
+		"cols":                           types.Int32Type,
+		"components":                     types.ObjectType{AttrTypes: formComponentsTFObjectTypes},
+		"field_types":                    types.SetType{ElemType: types.StringType},
+		"language_bundle":                types.MapType{ElemType: types.StringType},
+		"mark_optional":                  types.BoolType,
+		"mark_required":                  types.BoolType,
+		"password_auto_complete_enabled": types.BoolType,
+		"text_auto_complete_enabled":     types.BoolType,
+		"translation_method":             types.StringType,
+	}
+)
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &FormsDataSource{}
+	_ datasource.DataSourceWithConfigure = &FormsDataSource{}
+)
+
+// New Object
+func NewFormsDataSource() datasource.DataSource {
+	return &FormsDataSource{}
+}
+
+// Metadata
+func (r *FormsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forms"
+}
+
+// Schema
+func (r *FormsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	formsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of objects that describe the forms that have been retrieved, including each form's components and fields.",
+	)
+
+	// The form attributes are taken from the pingone_form resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&FormResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	formAttributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delete(formAttributes, "environment_id")
+
+	formAttributes["id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the form.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve multiple PingOne forms in an environment, including each form's components and fields.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to select forms from."),
+			),
+
+			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
+				"The list of resulting IDs of forms that have been successfully retrieved.",
+			)),
+
+			"forms": schema.ListNestedAttribute{
+				Description:         formsDescription.Description,
+				MarkdownDescription: formsDescription.MarkdownDescription,
+				Computed:            true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: formAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *FormsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *FormsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *FormsDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var formIDs []string
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := r.Client.ManagementAPIClient.FormManagementApi.ReadAllForms(ctx, data.EnvironmentId.ValueString()).Execute()
+
+			formIDs := make([]string, 0)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Forms != nil {
+					for _, form := range pageCursor.EntityArray.Embedded.GetForms() {
+						formIDs = append(formIDs, form.GetId())
+					}
+				}
+			}
+
+			return formIDs, initialHttpResponse, nil
+		},
+		"ReadAllForms",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&formIDs,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list response does not include the form components, so each form is read individually
+	forms := make([]management.Form, 0, len(formIDs))
+
+	for _, formID := range formIDs {
+		// Run the API call
+		var form *management.Form
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.ManagementAPIClient.FormManagementApi.ReadForm(ctx, data.EnvironmentId.ValueString(), formID).Include(management.ENUMFORMSINCLUDEPARAMETER_COMPONENTS).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadForm",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&form,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if form != nil {
+			forms = append(forms, *form)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(forms)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *FormsDataSourceModel) toState(apiObject []management.Form) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	tfObjType := types.ObjectType{AttrTypes: formsFormTFObjectTypes}
+
+	formIDs := make([]string, 0, len(apiObject))
+	forms := make([]attr.Value, 0, len(apiObject))
+	for _, v := range apiObject {
+		formModel := &formResourceModel{}
+		diags.Append(formModel.toState(&v)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		formIDs = append(formIDs, v.GetId())
+
+		attributesMap := map[string]attr.Value{
+			"id":                             formModel.Id,
+			"name":                           formModel.Name,
+			"description":                    formModel.Description,
+			"category":                       formModel.Category,
+			"cols":                           formModel.Cols,
+			"components":                     formModel.Components,
+			"field_types":                    formModel.FieldTypes,
+			"language_bundle":                formModel.LanguageBundle,
+			"mark_optional":                  formModel.MarkOptional,
+			"mark_required":                  formModel.MarkRequired,
+			"password_auto_complete_enabled": formModel.PasswordAutoCompleteEnabled,
+			"text_auto_complete_enabled":     formModel.TextAutoCompleteEnabled,
+			"translation_method":             formModel.TranslationMethod,
+		}
+
+		flattenedObj, d := types.ObjectValue(formsFormTFObjectTypes, attributesMap)
+		diags.Append(d...)
+
+		forms = append(forms, flattenedObj)
+	}
+
+	p.Ids, d = framework.StringSliceToTF(formIDs)
+	diags.Append(d...)
+
+	p.Forms, d = types.ListValue(tfObjType, forms)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,150 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type FormsRecaptchaV2DataSource serviceClientType
+
+type formsRecaptchaV2DataSourceModel struct {
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	SiteKey       types.String                 `tfsdk:"site_key"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &FormsRecaptchaV2DataSource{}
+	_ datasource.DataSourceWithConfigure = &FormsRecaptchaV2DataSource{}
+)
+
+// New Object
+func NewFormsRecaptchaV2DataSource() datasource.DataSource {
+	return &FormsRecaptchaV2DataSource{}
+}
+
+// Metadata
+func (r *FormsRecaptchaV2DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forms_recaptcha_v2"
+}
+
+// Schema
+func (r *FormsRecaptchaV2DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	siteKeyDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the public site key for the Recaptcha configuration provided by Google.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve the reCAPTCHA v2 configuration of a PingOne environment.  The confidential secret key is not returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the reCAPTCHA v2 configuration from."),
+			),
+
+			"site_key": schema.StringAttribute{
+				Description:         siteKeyDescription.Description,
+				MarkdownDescription: siteKeyDescription.MarkdownDescription,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *FormsRecaptchaV2DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *FormsRecaptchaV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *formsRecaptchaV2DataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *management.RecaptchaConfiguration
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.ManagementAPIClient.RecaptchaConfigurationApi.ReadRecaptchaConfiguration(ctx, data.EnvironmentId.ValueString()).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadRecaptchaConfiguration",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *formsRecaptchaV2DataSourceModel) toState(apiObject *management.RecaptchaConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.SiteKey = framework.StringOkToTF(apiObject.GetSiteKeyOk())
+
+	return diags
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+)
+
+func TestAccFormsRecaptchaV2DataSource_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_forms_recaptcha_v2.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_forms_recaptcha_v2.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.FormsRecaptchaV2_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFormsRecaptchaV2DataSourceConfig_Full(environmentName, licenseID, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "site_key", "test1234"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "secret_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFormsRecaptchaV2DataSourceConfig_Full(environmentName, licenseID, resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_forms_recaptcha_v2" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  site_key   = "test1234"
+  secret_key = "test4567"
+}
+
+data "pingone_forms_recaptcha_v2" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  depends_on = [pingone_forms_recaptcha_v2.%[3]s]
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName)
+}
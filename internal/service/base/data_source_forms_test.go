@@ -0,0 +1,159 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccFormsDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_forms.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.Form_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFormsDataSourceConfig_ByAll(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_form.%s-1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", fmt.Sprintf("pingone_form.%s-2", resourceName), "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "forms.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "forms.*", map[string]string{
+						"name":                     fmt.Sprintf("%s-1", name),
+						"cols":                     "4",
+						"components.fields.#":      "2",
+						"components.fields.0.type": "TEXT",
+						"components.fields.0.key":  "text-field-1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "forms.*", map[string]string{
+						"name":                     fmt.Sprintf("%s-2", name),
+						"cols":                     "4",
+						"components.fields.#":      "2",
+						"components.fields.0.type": "TEXT",
+						"components.fields.0.key":  "text-field-2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccFormsDataSourceConfig_ByAll(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_form" "%[3]s-1" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s-1"
+
+  cols = 4
+
+  components = {
+    fields = [
+      {
+        type = "TEXT"
+
+        position = {
+          row = 0
+          col = 0
+        }
+
+        label = "[{\"type\":\"paragraph\",\"children\":[{\"text\":\"Placeholder\"}]}]"
+
+        key = "text-field-1"
+
+        validation = {
+          type = "NONE"
+        }
+      },
+      {
+        type = "SUBMIT_BUTTON"
+
+        position = {
+          row = 1
+          col = 0
+        }
+
+        label = "[{\"type\":\"paragraph\",\"children\":[{\"text\":\"\"},{\"type\":\"i18n\",\"key\":\"button.text\",\"defaultTranslation\":\"Submit\",\"inline\":true,\"children\":[{\"text\":\"\"}]},{\"text\":\"\"}]}]"
+      }
+    ]
+  }
+}
+
+resource "pingone_form" "%[3]s-2" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s-2"
+
+  cols = 4
+
+  components = {
+    fields = [
+      {
+        type = "TEXT"
+
+        position = {
+          row = 0
+          col = 0
+        }
+
+        label = "[{\"type\":\"paragraph\",\"children\":[{\"text\":\"Placeholder\"}]}]"
+
+        key = "text-field-2"
+
+        validation = {
+          type = "NONE"
+        }
+      },
+      {
+        type = "SUBMIT_BUTTON"
+
+        position = {
+          row = 1
+          col = 0
+        }
+
+        label = "[{\"type\":\"paragraph\",\"children\":[{\"text\":\"\"},{\"type\":\"i18n\",\"key\":\"button.text\",\"defaultTranslation\":\"Submit\",\"inline\":true,\"children\":[{\"text\":\"\"}]},{\"text\":\"\"}]}]"
+      }
+    ]
+  }
+}
+
+data "pingone_forms" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  depends_on = [
+    pingone_form.%[3]s-1,
+    pingone_form.%[3]s-2,
+  ]
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
@@ -0,0 +1,319 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type GatewayInstancesDataSource serviceClientType
+
+type GatewayInstancesDataSourceModel struct {
+	Id                   pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId        pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	GatewayId            pingonetypes.ResourceIDValue `tfsdk:"gateway_id"`
+	Instances            types.List                   `tfsdk:"instances"`
+	HealthyInstanceCount types.Int32                  `tfsdk:"healthy_instance_count"`
+}
+
+var (
+	gatewayInstancesInstanceTFObjectTypes = map[string]attr.Type{
+		"id":               types.StringType,
+		"hostname":         types.StringType,
+		"connected":        types.BoolType,
+		"health_status":    types.StringType,
+		"current_errors":   types.ListType{ElemType: types.StringType},
+		"version":          types.StringType,
+		"update_status":    types.StringType,
+		"initialized_at":   types.StringType,
+		"last_reported_at": types.StringType,
+		"credential_id":    pingonetypes.ResourceIDType{},
+		"busy_percentage":  types.Int32Type,
+	}
+)
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &GatewayInstancesDataSource{}
+)
+
+// New Object
+func NewGatewayInstancesDataSource() datasource.DataSource {
+	return &GatewayInstancesDataSource{}
+}
+
+// Metadata
+func (r *GatewayInstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_instances"
+}
+
+// Schema
+func (r *GatewayInstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	healthStatusDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the health of the gateway instance.",
+	).AllowedValuesEnum(management.AllowedEnumHealthStatusEnumValues)
+
+	updateStatusDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies whether an update is available for the version of the gateway running for the instance.",
+	).AllowedValuesEnum(management.AllowedEnumUpdateStatusEnumValues)
+
+	healthyInstanceCountDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		fmt.Sprintf("An integer that specifies the number of gateway instances that are connected and have a `health_status` of `%s`.", string(management.ENUMHEALTHSTATUS_HEALTHY)),
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve the instances of a PingOne gateway that have registered with the PingOne service, including their connection and health status.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that contains the gateway to retrieve instances for."),
+			),
+
+			"gateway_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the gateway to retrieve instances for."),
+			),
+
+			"instances": schema.ListNestedAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A list of objects that describe the instances of the gateway.").Description,
+				Computed:    true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the instance ID of the gateway.  The gateway instance ID is created by the gateway when it starts up.").Description,
+							Computed:    true,
+						},
+
+						"hostname": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the hostname of the container running the gateway instance in the customer's infrastructure.").Description,
+							Computed:    true,
+						},
+
+						"connected": schema.BoolAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether the gateway instance has one or more connections to the PingOne service.").Description,
+							Computed:    true,
+						},
+
+						"health_status": schema.StringAttribute{
+							Description:         healthStatusDescription.Description,
+							MarkdownDescription: healthStatusDescription.MarkdownDescription,
+							Computed:            true,
+						},
+
+						"current_errors": schema.ListAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A list of the error messages that are currently maintained by the gateway instance.").Description,
+							Computed:    true,
+
+							ElementType: types.StringType,
+						},
+
+						"version": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the version number of the gateway running for the instance.").Description,
+							Computed:    true,
+						},
+
+						"update_status": schema.StringAttribute{
+							Description:         updateStatusDescription.Description,
+							MarkdownDescription: updateStatusDescription.MarkdownDescription,
+							Computed:            true,
+						},
+
+						"initialized_at": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A timestamp that specifies when the gateway instance was initialized (when it first connected to PingOne).").Description,
+							Computed:    true,
+						},
+
+						"last_reported_at": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A timestamp that specifies when the gateway instance last reported to PingOne, with a heartbeat or other message.").Description,
+							Computed:    true,
+						},
+
+						"credential_id": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the gateway credential that the gateway instance used to connect to PingOne.").Description,
+							Computed:    true,
+
+							CustomType: pingonetypes.ResourceIDType{},
+						},
+
+						"busy_percentage": schema.Int32Attribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the gateway instance's busy percentage.  When this percentage is high, more instances should be added.").Description,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"healthy_instance_count": schema.Int32Attribute{
+				Description:         healthyInstanceCountDescription.Description,
+				MarkdownDescription: healthyInstanceCountDescription.MarkdownDescription,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GatewayInstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *GatewayInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *GatewayInstancesDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var gatewayInstances []management.GatewayInstance
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := r.Client.ManagementAPIClient.GatewayInstancesApi.ReadAllGatewayInstances(ctx, data.EnvironmentId.ValueString(), data.GatewayId.ValueString()).Execute()
+
+			gatewayInstances := make([]management.GatewayInstance, 0)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.GatewayInstances != nil {
+					gatewayInstances = append(gatewayInstances, pageCursor.EntityArray.Embedded.GetGatewayInstances()...)
+				}
+			}
+
+			return gatewayInstances, initialHttpResponse, nil
+		},
+		"ReadAllGatewayInstances",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&gatewayInstances,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(gatewayInstances)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *GatewayInstancesDataSourceModel) toState(apiObject []management.GatewayInstance) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	healthyInstanceCount := int32(0)
+	instances := make([]attr.Value, 0, len(apiObject))
+
+	for _, gatewayInstance := range apiObject {
+		if gatewayInstance.GetConnected() && gatewayInstance.GetHealthStatus() == management.ENUMHEALTHSTATUS_HEALTHY {
+			healthyInstanceCount++
+		}
+
+		version := types.StringNull()
+		updateStatus := types.StringNull()
+		if v, ok := gatewayInstance.GetVersionOk(); ok {
+			version = framework.StringOkToTF(v.GetVersionNumberOk())
+			updateStatus = framework.EnumOkToTF(v.GetUpdateStatusOk())
+		}
+
+		credentialId := pingonetypes.NewResourceIDNull()
+		if v, ok := gatewayInstance.GetCredentialOk(); ok {
+			credentialId = framework.PingOneResourceIDOkToTF(v.GetIdOk())
+		}
+
+		instance, d := types.ObjectValue(gatewayInstancesInstanceTFObjectTypes, map[string]attr.Value{
+			"id":               framework.StringOkToTF(gatewayInstance.GetIdOk()),
+			"hostname":         framework.StringOkToTF(gatewayInstance.GetHostnameOk()),
+			"connected":        framework.BoolOkToTF(gatewayInstance.GetConnectedOk()),
+			"health_status":    framework.EnumOkToTF(gatewayInstance.GetHealthStatusOk()),
+			"current_errors":   framework.StringListOkToTF(gatewayInstance.GetCurrentErrorsOk()),
+			"version":          version,
+			"update_status":    updateStatus,
+			"initialized_at":   framework.StringOkToTF(gatewayInstance.GetInitializedAtOk()),
+			"last_reported_at": framework.StringOkToTF(gatewayInstance.GetLastReportedAtOk()),
+			"credential_id":    credentialId,
+			"busy_percentage":  framework.Int32OkToTF(gatewayInstance.GetBusyPercentageOk()),
+		})
+		diags.Append(d...)
+
+		instances = append(instances, instance)
+	}
+
+	var d diag.Diagnostics
+	p.Instances, d = types.ListValue(types.ObjectType{AttrTypes: gatewayInstancesInstanceTFObjectTypes}, instances)
+	diags.Append(d...)
+
+	p.HealthyInstanceCount = types.Int32Value(healthyInstanceCount)
+
+	return diags
+}
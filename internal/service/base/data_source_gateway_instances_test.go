@@ -0,0 +1,107 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccGatewayInstancesDataSource_NoInstances(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_gateway_instances.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.Gateway_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// A new gateway has no instances until a gateway is started with one of its credentials
+			{
+				Config: testAccGatewayInstancesDataSourceConfig_LDAPGateway(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "gateway_id", fmt.Sprintf("pingone_gateway.%s", resourceName), "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "instances.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "healthy_instance_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGatewayInstancesDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.Gateway_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGatewayInstancesDataSourceConfig_NotFound(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadAllGatewayInstances`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccGatewayInstancesDataSourceConfig_LDAPGateway(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_gateway" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+  enabled        = true
+  type           = "LDAP"
+
+  bind_dn       = "ou=test1,dc=example,dc=com"
+  bind_password = "dummyPasswordValue1"
+
+  connection_security = "TLS"
+  vendor              = "Microsoft Active Directory"
+
+  servers = [
+    "ds1.dummyldapservice.com:636",
+  ]
+}
+
+data "pingone_gateway_instances" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  gateway_id     = pingone_gateway.%[2]s.id
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccGatewayInstancesDataSourceConfig_NotFound(resourceName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+data "pingone_gateway_instances" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  gateway_id     = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -0,0 +1,209 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type IdentityPropagationRuleDataSource serviceClientType
+
+type identityPropagationRuleDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	RuleId        pingonetypes.ResourceIDValue `tfsdk:"rule_id"`
+	PlanId        pingonetypes.ResourceIDValue `tfsdk:"plan_id"`
+	SourceStoreId pingonetypes.ResourceIDValue `tfsdk:"source_store_id"`
+	TargetStoreId pingonetypes.ResourceIDValue `tfsdk:"target_store_id"`
+	Active        types.Bool                   `tfsdk:"active"`
+	Deprovision   types.Bool                   `tfsdk:"deprovision"`
+	Filter        types.String                 `tfsdk:"filter"`
+	PopulationIds types.Set                    `tfsdk:"population_ids"`
+	GroupIds      types.Set                    `tfsdk:"group_ids"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &IdentityPropagationRuleDataSource{}
+)
+
+// New Object
+func NewIdentityPropagationRuleDataSource() datasource.DataSource {
+	return &IdentityPropagationRuleDataSource{}
+}
+
+// Metadata
+func (r *IdentityPropagationRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_propagation_rule"
+}
+
+func (r *IdentityPropagationRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve a PingOne identity propagation (provisioning) rule in an environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("PingOne environment identifier (UUID) in which the identity propagation rule exists."),
+			),
+			"rule_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The identifier (UUID) of the identity propagation rule."),
+			),
+			"plan_id": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation plan that the rule belongs to.").Description,
+				Computed:    true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+			"source_store_id": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation store that users are synchronized from.").Description,
+				Computed:    true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+			"target_store_id": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation store that users are synchronized to.").Description,
+				Computed:    true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+			"active": schema.BoolAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether the rule is active.").Description,
+				Computed:    true,
+			},
+			"deprovision": schema.BoolAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether users are deprovisioned from the target store when they no longer match the rule.").Description,
+				Computed:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A SCIM filter that specifies the users in the source store that are synchronized to the target store.").Description,
+				Computed:    true,
+			},
+			"population_ids": schema.SetAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A set of IDs of populations, any of which a user must belong to for the user to be synchronized to the target store.").Description,
+				Computed:    true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+			},
+			"group_ids": schema.SetAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A set of IDs of groups, any of which a user must belong to for the user to be synchronized to the target store.").Description,
+				Computed:    true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+			},
+		},
+	}
+}
+
+func (r *IdentityPropagationRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *IdentityPropagationRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *identityPropagationRuleDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *identityPropagationRule
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readIdentityPropagationRule(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.RuleId.ValueString())
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOnePropagationRule",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if response == nil {
+		resp.Diagnostics.AddError(
+			"Identity propagation rule not found",
+			fmt.Sprintf("The identity propagation rule %s for environment %s cannot be found", data.RuleId.String(), data.EnvironmentId.String()),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *identityPropagationRuleDataSourceModel) toState(apiObject *identityPropagationRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.RuleId = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.PlanId = framework.PingOneResourceIDToTF(apiObject.Plan.Id)
+	p.SourceStoreId = framework.PingOneResourceIDToTF(apiObject.SourceStore.Id)
+	p.TargetStoreId = framework.PingOneResourceIDToTF(apiObject.TargetStore.Id)
+	p.Active = framework.BoolOkToTF(apiObject.Active, apiObject.Active != nil)
+	p.Deprovision = framework.BoolOkToTF(apiObject.Deprovision, apiObject.Deprovision != nil)
+	p.Filter = framework.StringOkToTF(apiObject.Filter, apiObject.Filter != nil)
+	p.PopulationIds = identityPropagationObjectReferencesToTF(apiObject.Populations)
+	p.GroupIds = identityPropagationObjectReferencesToTF(apiObject.Groups)
+
+	return diags
+}
@@ -0,0 +1,182 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type IdentityPropagationRuleMappingDataSource serviceClientType
+
+type identityPropagationRuleMappingDataSourceModel struct {
+	Id              pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId   pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	MappingId       pingonetypes.ResourceIDValue `tfsdk:"mapping_id"`
+	RuleId          pingonetypes.ResourceIDValue `tfsdk:"rule_id"`
+	SourceAttribute types.String                 `tfsdk:"source_attribute"`
+	Expression      types.String                 `tfsdk:"expression"`
+	TargetAttribute types.String                 `tfsdk:"target_attribute"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &IdentityPropagationRuleMappingDataSource{}
+)
+
+// New Object
+func NewIdentityPropagationRuleMappingDataSource() datasource.DataSource {
+	return &IdentityPropagationRuleMappingDataSource{}
+}
+
+// Metadata
+func (r *IdentityPropagationRuleMappingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_propagation_rule_mapping"
+}
+
+func (r *IdentityPropagationRuleMappingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve an attribute mapping of a PingOne identity propagation (provisioning) rule.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("PingOne environment identifier (UUID) in which the identity propagation rule mapping exists."),
+			),
+			"mapping_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The identifier (UUID) of the identity propagation rule mapping."),
+			),
+			"rule_id": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation rule that the mapping belongs to.").Description,
+				Computed:    true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+			"source_attribute": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the name of the attribute in the rule's source store that is mapped to the target attribute.").Description,
+				Computed:    true,
+			},
+			"expression": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies an expression, evaluated against the user in the rule's source store, that provides the value of the target attribute.").Description,
+				Computed:    true,
+			},
+			"target_attribute": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the name of the attribute in the rule's target store that is populated by the mapping.").Description,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *IdentityPropagationRuleMappingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *IdentityPropagationRuleMappingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *identityPropagationRuleMappingDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *identityPropagationRuleMapping
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readIdentityPropagationRuleMapping(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.MappingId.ValueString())
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOnePropagationMapping",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if response == nil {
+		resp.Diagnostics.AddError(
+			"Identity propagation rule mapping not found",
+			fmt.Sprintf("The identity propagation rule mapping %s for environment %s cannot be found", data.MappingId.String(), data.EnvironmentId.String()),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *identityPropagationRuleMappingDataSourceModel) toState(apiObject *identityPropagationRuleMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.MappingId = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.SourceAttribute = framework.StringOkToTF(apiObject.SourceAttribute, apiObject.SourceAttribute != nil)
+	p.Expression = framework.StringOkToTF(apiObject.Expression, apiObject.Expression != nil)
+	p.TargetAttribute = framework.StringToTF(apiObject.TargetAttribute)
+
+	if apiObject.Rule != nil {
+		p.RuleId = framework.PingOneResourceIDToTF(apiObject.Rule.Id)
+	} else {
+		p.RuleId = pingonetypes.NewResourceIDNull()
+	}
+
+	return diags
+}
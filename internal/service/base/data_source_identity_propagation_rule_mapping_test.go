@@ -0,0 +1,105 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+)
+
+func TestAccIdentityPropagationRuleMappingDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule_mapping.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRuleMapping_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPropagationRuleMappingDataSourceConfig_ByIDFull(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "mapping_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "rule_id", resourceFullName, "rule_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "source_attribute", resourceFullName, "source_attribute"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "expression", resourceFullName, "expression"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "target_attribute", resourceFullName, "target_attribute"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationRuleMappingDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRuleMapping_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityPropagationRuleMappingDataSourceConfig_NotFound(environmentName, licenseID, resourceName),
+				ExpectError: regexp.MustCompile(`Identity propagation rule mapping not found`),
+			},
+		},
+	})
+}
+
+func testAccIdentityPropagationRuleMappingDataSourceConfig_ByIDFull(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_rule_mapping" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  mapping_id = pingone_identity_propagation_rule_mapping.%[3]s.id
+}`, testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name), environmentName, resourceName)
+}
+
+func testAccIdentityPropagationRuleMappingDataSourceConfig_NotFound(environmentName, licenseID, resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_rule_mapping" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  mapping_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+)
+
+func TestAccIdentityPropagationRuleDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRule_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPropagationRuleDataSourceConfig_ByIDFull(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "rule_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "plan_id", resourceFullName, "plan_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "source_store_id", resourceFullName, "source_store_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "target_store_id", resourceFullName, "target_store_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "active", resourceFullName, "active"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "deprovision", resourceFullName, "deprovision"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "filter", resourceFullName, "filter"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "population_ids.#", resourceFullName, "population_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "group_ids.#", resourceFullName, "group_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationRuleDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRule_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityPropagationRuleDataSourceConfig_NotFound(environmentName, licenseID, resourceName),
+				ExpectError: regexp.MustCompile(`Identity propagation rule not found`),
+			},
+		},
+	})
+}
+
+func testAccIdentityPropagationRuleDataSourceConfig_ByIDFull(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_rule" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  rule_id = pingone_identity_propagation_rule.%[3]s.id
+}`, testAccIdentityPropagationRuleConfig_Full(environmentName, licenseID, resourceName, name), environmentName, resourceName)
+}
+
+func testAccIdentityPropagationRuleDataSourceConfig_NotFound(environmentName, licenseID, resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_rule" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  rule_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName)
+}
@@ -0,0 +1,271 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/jsontypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type IdentityPropagationStoreDataSource serviceClientType
+
+type identityPropagationStoreDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue     `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue     `tfsdk:"environment_id"`
+	StoreId       pingonetypes.ResourceIDValue     `tfsdk:"store_id"`
+	Name          types.String                     `tfsdk:"name"`
+	Description   types.String                     `tfsdk:"description"`
+	Type          types.String                     `tfsdk:"type"`
+	Configuration jsontypes.NormalizedObfuscatable `tfsdk:"configuration"`
+	Managed       types.Bool                       `tfsdk:"managed"`
+	Status        types.String                     `tfsdk:"status"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &IdentityPropagationStoreDataSource{}
+)
+
+// New Object
+func NewIdentityPropagationStoreDataSource() datasource.DataSource {
+	return &IdentityPropagationStoreDataSource{}
+}
+
+// Metadata
+func (r *IdentityPropagationStoreDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_propagation_store"
+}
+
+func (r *IdentityPropagationStoreDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// schema descriptions and validation settings
+	const attrMinLength = 1
+
+	storeIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The identifier (UUID) of the identity propagation store.",
+	).ExactlyOneOf([]string{"store_id", "name"}).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the identity propagation store.",
+	).ExactlyOneOf([]string{"store_id", "name"})
+
+	typeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the type of the identity propagation store.",
+	).AllowedValuesEnum(management.AllowedEnumPropagationStoreTypeEnumValues)
+
+	configurationDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A JSON string that specifies the configuration properties of the identity propagation store.  Secret values are returned obfuscated.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve a PingOne identity propagation (provisioning) store in an environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("PingOne environment identifier (UUID) in which the identity propagation store exists."),
+			),
+			"store_id": schema.StringAttribute{
+				Description:         storeIdDescription.Description,
+				MarkdownDescription: storeIdDescription.MarkdownDescription,
+				Optional:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         nameDescription.Description,
+				MarkdownDescription: nameDescription.MarkdownDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("store_id"),
+					),
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the description of the identity propagation store.").Description,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description:         typeDescription.Description,
+				MarkdownDescription: typeDescription.MarkdownDescription,
+				Computed:            true,
+			},
+			"configuration": schema.StringAttribute{
+				Description:         configurationDescription.Description,
+				MarkdownDescription: configurationDescription.MarkdownDescription,
+				Computed:            true,
+				Sensitive:           true,
+
+				CustomType: jsontypes.NormalizedObfuscatableType{},
+			},
+			"managed": schema.BoolAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether users provisioned to the store are deprovisioned when the store is deleted.").Description,
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the status of the identity propagation store.").Description,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *IdentityPropagationStoreDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *IdentityPropagationStoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *identityPropagationStoreDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propagationStore *management.PropagationStore
+
+	if !data.StoreId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.ManagementAPIClient.PropagationStoresApi.ReadOnePropagationStore(ctx, data.EnvironmentId.ValueString(), data.StoreId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOnePropagationStore",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&propagationStore,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		var stores []management.PropagationStore
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := readAllIdentityPropagationStores(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString())
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadAllStores",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&stores,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, store := range stores {
+			if strings.EqualFold(store.GetName(), data.Name.ValueString()) {
+				propagationStore = &store
+				break
+			}
+		}
+
+		if propagationStore == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find the identity propagation store from name",
+				fmt.Sprintf("The identity propagation store name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested PingOne identity propagation store: store_id or name argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(propagationStore)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *identityPropagationStoreDataSourceModel) toState(apiObject *management.PropagationStore) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDOkToTF(apiObject.GetIdOk())
+	p.EnvironmentId = framework.PingOneResourceIDToTF(*apiObject.GetEnvironment().Id)
+	p.StoreId = framework.PingOneResourceIDOkToTF(apiObject.GetIdOk())
+	p.Name = framework.StringOkToTF(apiObject.GetNameOk())
+	p.Description = framework.StringOkToTF(apiObject.GetDescriptionOk())
+	p.Type = framework.EnumOkToTF(apiObject.GetTypeOk())
+	p.Managed = framework.BoolOkToTF(apiObject.GetManagedOk())
+	p.Status = framework.EnumOkToTF(apiObject.GetStatusOk())
+
+	p.Configuration, d = identityPropagationStoreConfigurationToTF(jsontypes.NormalizedObfuscatableNull(), apiObject.GetConfiguration())
+	diags.Append(d...)
+
+	return diags
+}
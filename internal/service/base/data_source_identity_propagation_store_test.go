@@ -0,0 +1,173 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+)
+
+func TestAccIdentityPropagationStoreDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_store.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationStore_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPropagationStoreDataSourceConfig_ByIDFull(environmentName, licenseID, resourceName, name),
+				Check:  testAccIdentityPropagationStoreDataSourceCheck(resourceFullName, dataSourceFullName),
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationStoreDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_store.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationStore_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPropagationStoreDataSourceConfig_ByNameFull(environmentName, licenseID, resourceName, name),
+				Check:  testAccIdentityPropagationStoreDataSourceCheck(resourceFullName, dataSourceFullName),
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationStoreDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationStore_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityPropagationStoreDataSourceConfig_NotFoundByID(environmentName, licenseID, resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOnePropagationStore`: The request could not be completed. The requested resource was not found."),
+			},
+			{
+				Config:      testAccIdentityPropagationStoreDataSourceConfig_NotFoundByName(environmentName, licenseID, resourceName),
+				ExpectError: regexp.MustCompile(`Cannot find the identity propagation store from name`),
+			},
+		},
+	})
+}
+
+func testAccIdentityPropagationStoreDataSourceCheck(resourceFullName, dataSourceFullName string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "store_id", resourceFullName, "id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "description", resourceFullName, "description"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "type", resourceFullName, "type"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "managed", resourceFullName, "managed"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "status", resourceFullName, "status"),
+		resource.TestCheckResourceAttrSet(dataSourceFullName, "configuration"),
+	)
+}
+
+func testAccIdentityPropagationStoreDataSourceConfig_ByIDFull(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_store" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  store_id = pingone_identity_propagation_store.%[3]s.id
+}`, testAccIdentityPropagationStoreConfig_Full(environmentName, licenseID, resourceName, name), environmentName, resourceName)
+}
+
+func testAccIdentityPropagationStoreDataSourceConfig_ByNameFull(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_store" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+
+  depends_on = [
+    pingone_identity_propagation_store.%[3]s,
+  ]
+}`, testAccIdentityPropagationStoreConfig_Full(environmentName, licenseID, resourceName, name), environmentName, resourceName, name)
+}
+
+func testAccIdentityPropagationStoreDataSourceConfig_NotFoundByID(environmentName, licenseID, resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_store" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  store_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName)
+}
+
+func testAccIdentityPropagationStoreDataSourceConfig_NotFoundByName(environmentName, licenseID, resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_identity_propagation_store" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "doesnotexist"
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName)
+}
@@ -0,0 +1,350 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/utils"
+)
+
+// Types
+type NotificationTemplateContentsDataSource serviceClientType
+
+type NotificationTemplateContentsDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	TemplateName  types.String                 `tfsdk:"template_name"`
+	Contents      types.List                   `tfsdk:"contents"`
+}
+
+type notificationTemplateContentsTemplateContent struct {
+	TemplateName management.EnumTemplateName
+	Content      management.TemplateContent
+}
+
+var (
+	notificationTemplateContentsContentTFObjectTypes = map[string]attr.Type{
+		"id":            pingonetypes.ResourceIDType{},
+		"template_name": types.StringType,
+		"locale":        types.StringType,
+		"default":       types.BoolType,
+		"variant":       types.StringType,
+		"email":         types.ObjectType{AttrTypes: notificationTemplateContentEmailTFObjectTypes},
+		"push":          types.ObjectType{AttrTypes: notificationTemplateContentPushTFObjectTypes},
+		"sms":           types.ObjectType{AttrTypes: notificationTemplateContentSmsTFObjectTypes},
+		"voice":         types.ObjectType{AttrTypes: notificationTemplateContentVoiceTFObjectTypes},
+	}
+)
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &NotificationTemplateContentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &NotificationTemplateContentsDataSource{}
+)
+
+// New Object
+func NewNotificationTemplateContentsDataSource() datasource.DataSource {
+	return &NotificationTemplateContentsDataSource{}
+}
+
+// Metadata
+func (r *NotificationTemplateContentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_template_contents"
+}
+
+// Schema
+func (r *NotificationTemplateContentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	templateNameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the ID of the template to retrieve contents for.  If not set, the contents of all templates in the environment are returned.",
+	).AllowedValuesEnum(management.AllowedEnumTemplateNameEnumValues)
+
+	contentsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of objects that describe the notification template contents that have been retrieved, with one entry per template, locale, delivery method and variant combination.",
+	)
+
+	contentsTemplateNameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the ID of the template that the content belongs to.",
+	).AllowedValuesEnum(management.AllowedEnumTemplateNameEnumValues)
+
+	contentsLocaleDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the ISO standard language code of the template content. For more information about standard language codes, see [ISO Language Code Table](http://www.lingoes.net/en/translator/langcode.htm).",
+	)
+
+	// The template content attributes are taken from the pingone_notification_template_content resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&NotificationTemplateContentResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	contentAttributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delete(contentAttributes, "environment_id")
+
+	contentAttributes["id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the notification template content.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	contentAttributes["locale"] = schema.StringAttribute{
+		Description:         contentsLocaleDescription.Description,
+		MarkdownDescription: contentsLocaleDescription.MarkdownDescription,
+		Computed:            true,
+	}
+
+	contentAttributes["template_name"] = schema.StringAttribute{
+		Description:         contentsTemplateNameDescription.Description,
+		MarkdownDescription: contentsTemplateNameDescription.MarkdownDescription,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve the localised contents of PingOne notification templates in an environment, for each locale, delivery method and variant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve notification template contents from."),
+			),
+
+			"template_name": schema.StringAttribute{
+				Description:         templateNameDescription.Description,
+				MarkdownDescription: templateNameDescription.MarkdownDescription,
+				Optional:            true,
+
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.EnumSliceToStringSlice(management.AllowedEnumTemplateNameEnumValues)...),
+				},
+			},
+
+			"contents": schema.ListNestedAttribute{
+				Description:         contentsDescription.Description,
+				MarkdownDescription: contentsDescription.MarkdownDescription,
+				Computed:            true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: contentAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *NotificationTemplateContentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *NotificationTemplateContentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *NotificationTemplateContentsDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateNames := make([]management.EnumTemplateName, 0)
+
+	if !data.TemplateName.IsNull() {
+		templateNames = append(templateNames, management.EnumTemplateName(data.TemplateName.ValueString()))
+	} else {
+		// Run the API call
+		var templateIDs []string
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				pagedIterator := r.Client.ManagementAPIClient.NotificationsTemplatesApi.ReadAllTemplates(ctx, data.EnvironmentId.ValueString()).Execute()
+
+				templateIDs := make([]string, 0)
+
+				var initialHttpResponse *http.Response
+
+				for pageCursor, err := range pagedIterator {
+					if err != nil {
+						return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+					}
+
+					if initialHttpResponse == nil {
+						initialHttpResponse = pageCursor.HTTPResponse
+					}
+
+					if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Templates != nil {
+						for _, template := range pageCursor.EntityArray.Embedded.GetTemplates() {
+							templateIDs = append(templateIDs, template.GetId())
+						}
+					}
+				}
+
+				return templateIDs, initialHttpResponse, nil
+			},
+			"ReadAllTemplates",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&templateIDs,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, templateID := range templateIDs {
+			templateNames = append(templateNames, management.EnumTemplateName(templateID))
+		}
+	}
+
+	templateContents := make([]notificationTemplateContentsTemplateContent, 0)
+
+	for _, templateName := range templateNames {
+		// Run the API call
+		var contents []management.TemplateContent
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				pagedIterator := r.Client.ManagementAPIClient.NotificationsTemplatesApi.ReadAllTemplateContents(ctx, data.EnvironmentId.ValueString(), templateName).Execute()
+
+				contents := make([]management.TemplateContent, 0)
+
+				var initialHttpResponse *http.Response
+
+				for pageCursor, err := range pagedIterator {
+					if err != nil {
+						return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+					}
+
+					if initialHttpResponse == nil {
+						initialHttpResponse = pageCursor.HTTPResponse
+					}
+
+					if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Contents != nil {
+						contents = append(contents, pageCursor.EntityArray.Embedded.GetContents()...)
+					}
+				}
+
+				return contents, initialHttpResponse, nil
+			},
+			"ReadAllTemplateContents",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&contents,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, content := range contents {
+			templateContents = append(templateContents, notificationTemplateContentsTemplateContent{
+				TemplateName: templateName,
+				Content:      content,
+			})
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(templateContents)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *NotificationTemplateContentsDataSourceModel) toState(apiObject []notificationTemplateContentsTemplateContent) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	tfObjType := types.ObjectType{AttrTypes: notificationTemplateContentsContentTFObjectTypes}
+
+	contents := make([]attr.Value, 0, len(apiObject))
+	for _, v := range apiObject {
+		contentModel := &notificationTemplateContentResourceModelV1{}
+		diags.Append(contentModel.toState(&v.Content)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		attributesMap := map[string]attr.Value{
+			"id":            contentModel.Id,
+			"template_name": types.StringValue(string(v.TemplateName)),
+			"locale":        contentModel.Locale,
+			"default":       contentModel.Default,
+			"variant":       contentModel.Variant,
+			"email":         contentModel.Email,
+			"push":          contentModel.Push,
+			"sms":           contentModel.Sms,
+			"voice":         contentModel.Voice,
+		}
+
+		flattenedObj, d := types.ObjectValue(notificationTemplateContentsContentTFObjectTypes, attributesMap)
+		diags.Append(d...)
+
+		contents = append(contents, flattenedObj)
+	}
+
+	var d diag.Diagnostics
+	p.Contents, d = types.ListValue(tfObjType, contents)
+	diags.Append(d...)
+
+	return diags
+}
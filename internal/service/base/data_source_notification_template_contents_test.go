@@ -0,0 +1,175 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccNotificationTemplateContentsDataSource_ByTemplateName(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_notification_template_content.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_notification_template_contents.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	name := "verification_code_template"
+	locale := "en"
+	variant := "My New Variant"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.NotificationTemplateContent_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationTemplateContentsDataSourceConfig_ByTemplateName(environmentName, licenseID, resourceName, name, locale, variant),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(dataSourceFullName, "template_name", name),
+					resource.TestMatchResourceAttr(dataSourceFullName, "contents.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "contents.*.id", resourceFullName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "contents.*", map[string]string{
+						"template_name": name,
+						"locale":        locale,
+						"default":       "false",
+						"variant":       variant,
+						"email.subject": "Test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "contents.*", map[string]string{
+						"template_name": name,
+						"locale":        locale,
+						"default":       "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationTemplateContentsDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_notification_template_contents.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.NotificationTemplateContent_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationTemplateContentsDataSourceConfig_ByAll(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "template_name"),
+					resource.TestMatchResourceAttr(dataSourceFullName, "contents.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "contents.*", map[string]string{
+						"template_name": "verification_code_template",
+						"locale":        "en",
+						"default":       "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "contents.*", map[string]string{
+						"template_name": "strong_authentication",
+						"locale":        "en",
+						"default":       "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationTemplateContentsDataSource_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.NotificationTemplateContent_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNotificationTemplateContentsDataSourceConfig_InvalidTemplateName(resourceName),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func testAccNotificationTemplateContentsDataSourceConfig_ByTemplateName(environmentName, licenseID, resourceName, name, locale, variant string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_notification_template_content" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  template_name  = "%[4]s"
+  locale         = "%[5]s"
+  variant        = "%[6]s"
+
+  email = {
+    body    = <<EOT
+Test $${code.value}
+EOT
+    subject = "Test"
+  }
+}
+
+data "pingone_notification_template_contents" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  template_name  = "%[4]s"
+
+  depends_on = [pingone_notification_template_content.%[3]s]
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name, locale, variant)
+}
+
+func testAccNotificationTemplateContentsDataSourceConfig_ByAll(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_notification_template_contents" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccNotificationTemplateContentsDataSourceConfig_InvalidTemplateName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_notification_template_contents" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  template_name  = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
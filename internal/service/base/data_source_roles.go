@@ -8,10 +8,13 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
 	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
 	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
 	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
@@ -22,8 +25,10 @@ import (
 type RolesDataSource serviceClientType
 
 type RolesDataSourceModel struct {
-	Id  pingonetypes.ResourceIDValue `tfsdk:"id"`
-	Ids types.List                   `tfsdk:"ids"`
+	Id             pingonetypes.ResourceIDValue `tfsdk:"id"`
+	Ids            types.List                   `tfsdk:"ids"`
+	IncludeDetails types.Bool                   `tfsdk:"include_details"`
+	Items          types.List                   `tfsdk:"items"`
 }
 
 // Framework interfaces
@@ -44,6 +49,29 @@ func (r *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 // Schema
 func (r *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 
+	// The returned role attributes are taken from the pingone_role data source schema, so each item has the same shape as the singular data source
+	roleSchemaResp := datasource.SchemaResponse{}
+	(&RoleDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &roleSchemaResp)
+	resp.Diagnostics.Append(roleSchemaResp.Diagnostics...)
+
+	itemAttributes, d := framework.DataSourceComputedAttributesFromDataSourceAttributes(roleSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemAttributes["role_id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the role.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	itemAttributes["name"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The name of the role.").Description,
+		Computed:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Datasource to retrieve a list of role IDs in the active PingOne tenant.",
@@ -54,6 +82,14 @@ func (r *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
 				"The list of resulting IDs of roles that have been successfully retrieved.",
 			)),
+
+			"include_details": framework.Attr_DataSourceIncludeDetails(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A boolean that specifies whether the full details of each role should be returned in the `items` attribute.  The details are taken from the same paged list response used to populate `ids`, so no additional API calls are made.",
+			)),
+
+			"items": framework.Attr_DataSourceReturnItems(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A list of objects that describe the roles that have been successfully retrieved, with the same attributes as the `pingone_role` data source.  Only populated when `include_details` is set to `true`.",
+			), itemAttributes),
 		},
 	}
 }
@@ -102,14 +138,14 @@ func (r *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Run the API call
-	var roleIDs []string
+	var roles []management.EntityArrayEmbeddedRolesInner
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
 		func() (any, *http.Response, error) {
 			pagedIterator := r.Client.ManagementAPIClient.RolesApi.ReadAllRoles(ctx).Execute()
 
-			roleIDs := make([]string, 0)
+			roles := make([]management.EntityArrayEmbeddedRolesInner, 0)
 
 			var initialHttpResponse *http.Response
 
@@ -123,29 +159,33 @@ func (r *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				}
 
 				if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Roles != nil {
-					for _, item := range pageCursor.EntityArray.Embedded.GetRoles() {
-						roleIDs = append(roleIDs, item.Role.GetId())
-					}
+					roles = append(roles, pageCursor.EntityArray.Embedded.GetRoles()...)
 				}
 			}
 
-			return roleIDs, initialHttpResponse, nil
+			return roles, initialHttpResponse, nil
 		},
 		"ReadAllRoles",
 		legacysdk.DefaultCustomError,
 		sdk.DefaultCreateReadRetryable,
-		&roleIDs,
+		&roles,
 	)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	itemsType, d := req.Config.Schema.TypeAtPath(ctx, path.Root("items"))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(data.toState(roleIDs)...)
+	resp.Diagnostics.Append(data.toState(ctx, roles, itemsType)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (p *RolesDataSourceModel) toState(roleList []string) diag.Diagnostics {
+func (p *RolesDataSourceModel) toState(ctx context.Context, roleList []management.EntityArrayEmbeddedRolesInner, itemsType attr.Type) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if roleList == nil {
@@ -163,7 +203,33 @@ func (p *RolesDataSourceModel) toState(roleList []string) diag.Diagnostics {
 		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
 	}
 
-	p.Ids, d = framework.StringSliceToTF(roleList)
+	roleIDs := make([]string, 0, len(roleList))
+	var items []RoleDataSourceModel
+
+	if p.IncludeDetails.ValueBool() {
+		items = make([]RoleDataSourceModel, 0, len(roleList))
+	}
+
+	for _, roleItem := range roleList {
+		roleIDs = append(roleIDs, roleItem.Role.GetId())
+
+		if items != nil && roleItem.Role != nil {
+			item := RoleDataSourceModel{}
+			diags.Append(item.toState(roleItem.Role)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			item.RoleId = item.Id
+
+			items = append(items, item)
+		}
+	}
+
+	p.Ids, d = framework.StringSliceToTF(roleIDs)
+	diags.Append(d...)
+
+	p.Items, d = framework.DataSourceItemsToTF(ctx, itemsType, items)
 	diags.Append(d...)
 
 	return diags
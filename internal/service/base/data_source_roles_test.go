@@ -34,6 +34,38 @@ func TestAccRolesDataSource_All(t *testing.T) {
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.#", regexp.MustCompile(`^[1-9]\d*$`)),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.0", verify.P1ResourceIDRegexpFullString),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.1", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesDataSource_IncludeDetails(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_roles.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolesDataSourceConfig_IncludeDetails(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "items.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.#", dataSourceFullName, "ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.id", dataSourceFullName, "ids.0"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.role_id", dataSourceFullName, "ids.0"),
+					resource.TestMatchResourceAttr(dataSourceFullName, "items.0.name", regexp.MustCompile(`^[A-Za-z ]+$`)),
+					resource.TestMatchResourceAttr(dataSourceFullName, "items.0.applicable_to.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr(dataSourceFullName, "items.0.permissions.#", regexp.MustCompile(`^[1-9]\d*$`)),
 				),
 			},
 		},
@@ -45,3 +77,10 @@ func testAccRolesDataSourceConfig_All(resourceName string) string {
 data "pingone_roles" "%[1]s" {
 }`, resourceName)
 }
+
+func testAccRolesDataSourceConfig_IncludeDetails(resourceName string) string {
+	return fmt.Sprintf(`
+data "pingone_roles" "%[1]s" {
+  include_details = true
+}`, resourceName)
+}
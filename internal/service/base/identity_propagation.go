@@ -0,0 +1,228 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/jsontypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+// The SDK's propagation rule and mapping operations do not return a model, and the stores collection is not included in the SDK's entity array, so the requests and responses are handled with these types.
+
+type identityPropagationObjectReference struct {
+	Id string `json:"id"`
+}
+
+type identityPropagationRule struct {
+	Id          string                               `json:"id,omitempty"`
+	Plan        identityPropagationObjectReference   `json:"plan"`
+	SourceStore identityPropagationObjectReference   `json:"sourceStore"`
+	TargetStore identityPropagationObjectReference   `json:"targetStore"`
+	Active      *bool                                `json:"active,omitempty"`
+	Deprovision *bool                                `json:"deprovision,omitempty"`
+	Filter      *string                              `json:"filter,omitempty"`
+	Populations []identityPropagationObjectReference `json:"populations,omitempty"`
+	Groups      []identityPropagationObjectReference `json:"groups,omitempty"`
+}
+
+type identityPropagationRuleMapping struct {
+	Id              string                              `json:"id,omitempty"`
+	Rule            *identityPropagationObjectReference `json:"rule,omitempty"`
+	SourceAttribute *string                             `json:"sourceAttribute,omitempty"`
+	TargetAttribute string                              `json:"targetAttribute"`
+	Expression      *string                             `json:"expression,omitempty"`
+}
+
+type identityPropagationStoreCollection struct {
+	Embedded struct {
+		Stores []management.PropagationStore `json:"stores"`
+	} `json:"_embedded"`
+}
+
+func identityPropagationObjectReferencesFromIDs(ids []string) []identityPropagationObjectReference {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	references := make([]identityPropagationObjectReference, 0, len(ids))
+	for _, id := range ids {
+		references = append(references, identityPropagationObjectReference{Id: id})
+	}
+
+	return references
+}
+
+// identityPropagationObjectReferencesToTF returns a null set when there are no references, as the API returns an empty array for an unset value
+func identityPropagationObjectReferencesToTF(references []identityPropagationObjectReference) basetypes.SetValue {
+	if len(references) == 0 {
+		return types.SetNull(pingonetypes.ResourceIDType{})
+	}
+
+	ids := make([]string, 0, len(references))
+	for _, reference := range references {
+		ids = append(ids, reference.Id)
+	}
+
+	return framework.PingOneResourceIDSetToTF(ids)
+}
+
+func identityPropagationRulePath(environmentID, ruleID string) string {
+	return fmt.Sprintf("/environments/%s/propagation/rules/%s", url.PathEscape(environmentID), url.PathEscape(ruleID))
+}
+
+func identityPropagationRuleMappingPath(environmentID, mappingID string) string {
+	return fmt.Sprintf("/environments/%s/propagation/mappings/%s", url.PathEscape(environmentID), url.PathEscape(mappingID))
+}
+
+func createIdentityPropagationRule(ctx context.Context, apiClient *management.APIClient, environmentID string, rule identityPropagationRule) (any, *http.Response, error) {
+	var response identityPropagationRule
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPost, fmt.Sprintf("/environments/%s/propagation/rules", url.PathEscape(environmentID)), "", rule, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+// readIdentityPropagationRule returns a nil rule, without error, when the rule cannot be found
+func readIdentityPropagationRule(ctx context.Context, apiClient *management.APIClient, environmentID, ruleID string) (any, *http.Response, error) {
+	var response identityPropagationRule
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, identityPropagationRulePath(environmentID, ruleID), "", nil, &response)
+	if fR != nil && fR.StatusCode == http.StatusNotFound {
+		return nil, nil, nil
+	}
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func updateIdentityPropagationRule(ctx context.Context, apiClient *management.APIClient, environmentID, ruleID string, rule identityPropagationRule) (any, *http.Response, error) {
+	var response identityPropagationRule
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPut, identityPropagationRulePath(environmentID, ruleID), "", rule, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func deleteIdentityPropagationRule(ctx context.Context, apiClient *management.APIClient, environmentID, ruleID string) (*http.Response, error) {
+	return legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodDelete, identityPropagationRulePath(environmentID, ruleID), "", nil, nil)
+}
+
+func createIdentityPropagationRuleMapping(ctx context.Context, apiClient *management.APIClient, environmentID, ruleID string, mapping identityPropagationRuleMapping) (any, *http.Response, error) {
+	var response identityPropagationRuleMapping
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPost, identityPropagationRulePath(environmentID, ruleID)+"/mappings", "", mapping, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+// readIdentityPropagationRuleMapping returns a nil mapping, without error, when the mapping cannot be found
+func readIdentityPropagationRuleMapping(ctx context.Context, apiClient *management.APIClient, environmentID, mappingID string) (any, *http.Response, error) {
+	var response identityPropagationRuleMapping
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, identityPropagationRuleMappingPath(environmentID, mappingID), "", nil, &response)
+	if fR != nil && fR.StatusCode == http.StatusNotFound {
+		return nil, nil, nil
+	}
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func updateIdentityPropagationRuleMapping(ctx context.Context, apiClient *management.APIClient, environmentID, mappingID string, mapping identityPropagationRuleMapping) (any, *http.Response, error) {
+	var response identityPropagationRuleMapping
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPut, identityPropagationRuleMappingPath(environmentID, mappingID), "", mapping, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func deleteIdentityPropagationRuleMapping(ctx context.Context, apiClient *management.APIClient, environmentID, mappingID string) (*http.Response, error) {
+	return legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodDelete, identityPropagationRuleMappingPath(environmentID, mappingID), "", nil, nil)
+}
+
+func readAllIdentityPropagationStores(ctx context.Context, apiClient *management.APIClient, environmentID string) (any, *http.Response, error) {
+	var response identityPropagationStoreCollection
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, fmt.Sprintf("/environments/%s/propagation/stores", url.PathEscape(environmentID)), "", nil, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	stores := response.Embedded.Stores
+	if stores == nil {
+		stores = make([]management.PropagationStore, 0)
+	}
+
+	return stores, fR, nil
+}
+
+// identityPropagationStoreConfigurationToTF converts the store configuration returned by the API to state.  When a prior configuration is known,
+// only the keys in the prior configuration are kept, so that service defaults do not show as drift, and the prior values of secrets that the
+// API returns obfuscated (as a string of asterisks) are retained.
+func identityPropagationStoreConfigurationToTF(prior jsontypes.NormalizedObfuscatable, remote map[string]interface{}) (jsontypes.NormalizedObfuscatable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if remote == nil {
+		return jsontypes.NormalizedObfuscatableNull(), diags
+	}
+
+	configuration := remote
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorConfiguration map[string]interface{}
+		if err := json.Unmarshal([]byte(prior.ValueString()), &priorConfiguration); err != nil {
+			diags.AddError(
+				"Cannot parse the store configuration",
+				fmt.Sprintf("The prior store configuration cannot be parsed as a JSON object: %s", err.Error()),
+			)
+			return prior, diags
+		}
+
+		configuration = make(map[string]interface{}, len(priorConfiguration))
+		for k, priorValue := range priorConfiguration {
+			remoteValue, ok := remote[k]
+			if !ok {
+				continue
+			}
+
+			if v, ok := remoteValue.(string); ok && v != "" && strings.Trim(v, "*") == "" {
+				remoteValue = priorValue
+			}
+
+			configuration[k] = remoteValue
+		}
+	}
+
+	configurationBytes, err := json.Marshal(configuration)
+	if err != nil {
+		diags.AddError(
+			"Cannot convert the store configuration",
+			fmt.Sprintf("The store configuration cannot be converted to JSON: %s", err.Error()),
+		)
+		return jsontypes.NormalizedObfuscatableNull(), diags
+	}
+
+	return jsontypes.NormalizedObfuscatableStringValue(string(configurationBytes)), diags
+}
@@ -0,0 +1,474 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// Types
+type IdentityPropagationRuleResource serviceClientType
+
+type identityPropagationRuleResourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	PlanId        pingonetypes.ResourceIDValue `tfsdk:"plan_id"`
+	SourceStoreId pingonetypes.ResourceIDValue `tfsdk:"source_store_id"`
+	TargetStoreId pingonetypes.ResourceIDValue `tfsdk:"target_store_id"`
+	Active        types.Bool                   `tfsdk:"active"`
+	Deprovision   types.Bool                   `tfsdk:"deprovision"`
+	Filter        types.String                 `tfsdk:"filter"`
+	PopulationIds types.Set                    `tfsdk:"population_ids"`
+	GroupIds      types.Set                    `tfsdk:"group_ids"`
+}
+
+// Framework interfaces
+var (
+	_ resource.Resource                = &IdentityPropagationRuleResource{}
+	_ resource.ResourceWithConfigure   = &IdentityPropagationRuleResource{}
+	_ resource.ResourceWithImportState = &IdentityPropagationRuleResource{}
+)
+
+// New Object
+func NewIdentityPropagationRuleResource() resource.Resource {
+	return &IdentityPropagationRuleResource{}
+}
+
+// Metadata
+func (r *IdentityPropagationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_propagation_rule"
+}
+
+// Schema.
+func (r *IdentityPropagationRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	activeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that specifies whether the rule is active.  Users are only synchronized to the target store when the rule is active.",
+	).DefaultValue(true)
+
+	deprovisionDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that specifies whether users are deprovisioned from the target store when they no longer match the rule.",
+	).DefaultValue(false)
+
+	filterDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A SCIM filter that specifies the users in the source store that are synchronized to the target store.  For example, `email ew \"@bxretail.org\"`.",
+	)
+
+	populationIdsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A set of IDs of populations, any of which a user must belong to for the user to be synchronized to the target store.  Values must be valid PingOne resource IDs.",
+	)
+
+	groupIdsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A set of IDs of groups, any of which a user must belong to for the user to be synchronized to the target store.  Values must be valid PingOne resource IDs.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to create and manage PingOne identity propagation (provisioning) rules, that synchronize users from a source store to a target store as part of an identity propagation plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to manage the identity propagation rule in."),
+			),
+
+			"plan_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation plan that the rule belongs to."),
+			),
+
+			"source_store_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation store that users are synchronized from."),
+			),
+
+			"target_store_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation store that users are synchronized to."),
+			),
+
+			"active": schema.BoolAttribute{
+				Description:         activeDescription.Description,
+				MarkdownDescription: activeDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				Default: booldefault.StaticBool(true),
+			},
+
+			"deprovision": schema.BoolAttribute{
+				Description:         deprovisionDescription.Description,
+				MarkdownDescription: deprovisionDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				Default: booldefault.StaticBool(false),
+			},
+
+			"filter": schema.StringAttribute{
+				Description:         filterDescription.Description,
+				MarkdownDescription: filterDescription.MarkdownDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"population_ids": schema.SetAttribute{
+				Description:         populationIdsDescription.Description,
+				MarkdownDescription: populationIdsDescription.MarkdownDescription,
+				Optional:            true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(attrMinLength),
+				},
+			},
+
+			"group_ids": schema.SetAttribute{
+				Description:         groupIdsDescription.Description,
+				MarkdownDescription: groupIdsDescription.MarkdownDescription,
+				Optional:            true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(attrMinLength),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdentityPropagationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *IdentityPropagationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state identityPropagationRuleResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	propagationRule, d := plan.expand(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *identityPropagationRule
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := createIdentityPropagationRule(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), *propagationRule)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"CreatePropagationRule",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IdentityPropagationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *identityPropagationRuleResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *identityPropagationRule
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readIdentityPropagationRule(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.Id.ValueString())
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOnePropagationRule",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if response == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdentityPropagationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state identityPropagationRuleResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	propagationRule, d := plan.expand(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *identityPropagationRule
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := updateIdentityPropagationRule(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), *propagationRule)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"UpdatePropagationRule",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IdentityPropagationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *identityPropagationRuleResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := deleteIdentityPropagationRule(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.Id.ValueString())
+			if fR != nil && fR.StatusCode == http.StatusNotFound {
+				return nil, nil, nil
+			}
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, fR, fErr)
+		},
+		"DeletePropagationRule",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		nil,
+		nil,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdentityPropagationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "identity_propagation_rule_id",
+			Regexp:    verify.P1ResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathIdentityPropagationRule := idComponent.Label
+
+		if idComponent.PrimaryID {
+			pathIdentityPropagationRule = "id"
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathIdentityPropagationRule), attributes[idComponent.Label])...)
+	}
+}
+
+func (p *identityPropagationRuleResourceModel) expand(ctx context.Context) (*identityPropagationRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &identityPropagationRule{
+		Plan: identityPropagationObjectReference{
+			Id: p.PlanId.ValueString(),
+		},
+		SourceStore: identityPropagationObjectReference{
+			Id: p.SourceStoreId.ValueString(),
+		},
+		TargetStore: identityPropagationObjectReference{
+			Id: p.TargetStoreId.ValueString(),
+		},
+		Active:      p.Active.ValueBoolPointer(),
+		Deprovision: p.Deprovision.ValueBoolPointer(),
+	}
+
+	if !p.Filter.IsNull() && !p.Filter.IsUnknown() {
+		data.Filter = p.Filter.ValueStringPointer()
+	}
+
+	if !p.PopulationIds.IsNull() && !p.PopulationIds.IsUnknown() {
+		var populationIDs []string
+		diags.Append(p.PopulationIds.ElementsAs(ctx, &populationIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		data.Populations = identityPropagationObjectReferencesFromIDs(populationIDs)
+	}
+
+	if !p.GroupIds.IsNull() && !p.GroupIds.IsUnknown() {
+		var groupIDs []string
+		diags.Append(p.GroupIds.ElementsAs(ctx, &groupIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		data.Groups = identityPropagationObjectReferencesFromIDs(groupIDs)
+	}
+
+	return data, diags
+}
+
+func (p *identityPropagationRuleResourceModel) toState(apiObject *identityPropagationRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.PlanId = framework.PingOneResourceIDToTF(apiObject.Plan.Id)
+	p.SourceStoreId = framework.PingOneResourceIDToTF(apiObject.SourceStore.Id)
+	p.TargetStoreId = framework.PingOneResourceIDToTF(apiObject.TargetStore.Id)
+	p.Filter = framework.StringOkToTF(apiObject.Filter, apiObject.Filter != nil)
+	p.PopulationIds = identityPropagationObjectReferencesToTF(apiObject.Populations)
+	p.GroupIds = identityPropagationObjectReferencesToTF(apiObject.Groups)
+
+	if apiObject.Active != nil {
+		p.Active = types.BoolValue(*apiObject.Active)
+	}
+
+	if apiObject.Deprovision != nil {
+		p.Deprovision = types.BoolValue(*apiObject.Deprovision)
+	}
+
+	return diags
+}
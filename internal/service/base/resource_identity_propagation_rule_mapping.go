@@ -0,0 +1,396 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// Types
+type IdentityPropagationRuleMappingResource serviceClientType
+
+type identityPropagationRuleMappingResourceModel struct {
+	Id              pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId   pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	RuleId          pingonetypes.ResourceIDValue `tfsdk:"rule_id"`
+	SourceAttribute types.String                 `tfsdk:"source_attribute"`
+	Expression      types.String                 `tfsdk:"expression"`
+	TargetAttribute types.String                 `tfsdk:"target_attribute"`
+}
+
+// Framework interfaces
+var (
+	_ resource.Resource                = &IdentityPropagationRuleMappingResource{}
+	_ resource.ResourceWithConfigure   = &IdentityPropagationRuleMappingResource{}
+	_ resource.ResourceWithImportState = &IdentityPropagationRuleMappingResource{}
+)
+
+// New Object
+func NewIdentityPropagationRuleMappingResource() resource.Resource {
+	return &IdentityPropagationRuleMappingResource{}
+}
+
+// Metadata
+func (r *IdentityPropagationRuleMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_propagation_rule_mapping"
+}
+
+// Schema.
+func (r *IdentityPropagationRuleMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	sourceAttributeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the name of the attribute in the rule's source store that is mapped to the target attribute.  For example, `email`.",
+	).ExactlyOneOf([]string{"source_attribute", "expression"})
+
+	expressionDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies an expression, evaluated against the user in the rule's source store, that provides the value of the target attribute.",
+	).ExactlyOneOf([]string{"source_attribute", "expression"})
+
+	targetAttributeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the name of the attribute in the rule's target store that is populated by the mapping.  For example, `userName`.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to create and manage attribute mappings for PingOne identity propagation (provisioning) rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to manage the identity propagation rule mapping in."),
+			),
+
+			"rule_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the identity propagation rule to manage the attribute mapping for."),
+			),
+
+			"source_attribute": schema.StringAttribute{
+				Description:         sourceAttributeDescription.Description,
+				MarkdownDescription: sourceAttributeDescription.MarkdownDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("expression"),
+					),
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"expression": schema.StringAttribute{
+				Description:         expressionDescription.Description,
+				MarkdownDescription: expressionDescription.MarkdownDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("source_attribute"),
+					),
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"target_attribute": schema.StringAttribute{
+				Description:         targetAttributeDescription.Description,
+				MarkdownDescription: targetAttributeDescription.MarkdownDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdentityPropagationRuleMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *IdentityPropagationRuleMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state identityPropagationRuleMappingResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	propagationMapping := plan.expand()
+
+	// Run the API call
+	var response *identityPropagationRuleMapping
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := createIdentityPropagationRuleMapping(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.RuleId.ValueString(), *propagationMapping)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"CreatePropagationMapping",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IdentityPropagationRuleMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *identityPropagationRuleMappingResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *identityPropagationRuleMapping
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readIdentityPropagationRuleMapping(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.Id.ValueString())
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOnePropagationMapping",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if response == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdentityPropagationRuleMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state identityPropagationRuleMappingResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	propagationMapping := plan.expand()
+
+	// Run the API call
+	var response *identityPropagationRuleMapping
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := updateIdentityPropagationRuleMapping(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), *propagationMapping)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"UpdatePropagationMapping",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IdentityPropagationRuleMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *identityPropagationRuleMappingResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := deleteIdentityPropagationRuleMapping(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.Id.ValueString())
+			if fR != nil && fR.StatusCode == http.StatusNotFound {
+				return nil, nil, nil
+			}
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, fR, fErr)
+		},
+		"DeletePropagationMapping",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		nil,
+		nil,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdentityPropagationRuleMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:  "rule_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "identity_propagation_rule_mapping_id",
+			Regexp:    verify.P1ResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathIdentityPropagationRuleMapping := idComponent.Label
+
+		if idComponent.PrimaryID {
+			pathIdentityPropagationRuleMapping = "id"
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathIdentityPropagationRuleMapping), attributes[idComponent.Label])...)
+	}
+}
+
+func (p *identityPropagationRuleMappingResourceModel) expand() *identityPropagationRuleMapping {
+
+	data := &identityPropagationRuleMapping{
+		TargetAttribute: p.TargetAttribute.ValueString(),
+	}
+
+	if !p.SourceAttribute.IsNull() && !p.SourceAttribute.IsUnknown() {
+		data.SourceAttribute = p.SourceAttribute.ValueStringPointer()
+	}
+
+	if !p.Expression.IsNull() && !p.Expression.IsUnknown() {
+		data.Expression = p.Expression.ValueStringPointer()
+	}
+
+	return data
+}
+
+func (p *identityPropagationRuleMappingResourceModel) toState(apiObject *identityPropagationRuleMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.SourceAttribute = framework.StringOkToTF(apiObject.SourceAttribute, apiObject.SourceAttribute != nil)
+	p.Expression = framework.StringOkToTF(apiObject.Expression, apiObject.Expression != nil)
+	p.TargetAttribute = framework.StringToTF(apiObject.TargetAttribute)
+
+	if apiObject.Rule != nil {
+		p.RuleId = framework.PingOneResourceIDToTF(apiObject.Rule.Id)
+	}
+
+	return diags
+}
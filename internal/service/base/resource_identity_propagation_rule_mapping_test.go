@@ -0,0 +1,255 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccIdentityPropagationRuleMapping_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule_mapping.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var propagationRuleMappingID, propagationRuleID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRuleMapping_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name),
+				Check:  base.IdentityPropagationRuleMapping_GetIDs(resourceFullName, &environmentID, &propagationRuleMappingID),
+			},
+			{
+				PreConfig: func() {
+					base.IdentityPropagationRuleMapping_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, propagationRuleMappingID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the rule
+			{
+				Config: testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					base.IdentityPropagationRuleMapping_GetIDs(resourceFullName, &environmentID, &propagationRuleMappingID),
+					base.IdentityPropagationRule_GetIDs(fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName), nil, &propagationRuleID),
+				),
+			},
+			{
+				PreConfig: func() {
+					base.IdentityPropagationRule_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, propagationRuleID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationRuleMapping_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule_mapping.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	sourceAttributeStep := resource.TestStep{
+		Config: testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttrPair(resourceFullName, "rule_id", fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName), "id"),
+			resource.TestCheckResourceAttr(resourceFullName, "source_attribute", "email"),
+			resource.TestCheckNoResourceAttr(resourceFullName, "expression"),
+			resource.TestCheckResourceAttr(resourceFullName, "target_attribute", "userName"),
+		),
+	}
+
+	expressionStep := resource.TestStep{
+		Config: testAccIdentityPropagationRuleMappingConfig_Expression(environmentName, licenseID, resourceName, name),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttrPair(resourceFullName, "rule_id", fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName), "id"),
+			resource.TestCheckNoResourceAttr(resourceFullName, "source_attribute"),
+			resource.TestCheckResourceAttr(resourceFullName, "expression", "${user.name.given} ${user.name.family}"),
+			resource.TestCheckResourceAttr(resourceFullName, "target_attribute", "displayName"),
+		),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRuleMapping_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Source attribute
+			sourceAttributeStep,
+			{
+				Config:  testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name),
+				Destroy: true,
+			},
+			// Expression
+			expressionStep,
+			{
+				Config:  testAccIdentityPropagationRuleMappingConfig_Expression(environmentName, licenseID, resourceName, name),
+				Destroy: true,
+			},
+			// Change
+			sourceAttributeStep,
+			expressionStep,
+			sourceAttributeStep,
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["rule_id"], rs.Primary.ID), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationRuleMapping_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule_mapping.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRuleMapping_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityPropagationRuleMappingConfig_SourceAttributeAndExpression(environmentName, licenseID, resourceName, name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Configure
+			{
+				Config: testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccIdentityPropagationRuleMappingConfig_SourceAttribute(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_rule_mapping" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  rule_id        = pingone_identity_propagation_rule.%[3]s.id
+
+  source_attribute = "email"
+  target_attribute = "userName"
+}`, testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name), environmentName, resourceName)
+}
+
+func testAccIdentityPropagationRuleMappingConfig_Expression(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_rule_mapping" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  rule_id        = pingone_identity_propagation_rule.%[3]s.id
+
+  expression       = "$${user.name.given} $${user.name.family}"
+  target_attribute = "displayName"
+}`, testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name), environmentName, resourceName)
+}
+
+func testAccIdentityPropagationRuleMappingConfig_SourceAttributeAndExpression(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_rule_mapping" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  rule_id        = pingone_identity_propagation_rule.%[3]s.id
+
+  source_attribute = "email"
+  expression       = "$${user.email}"
+  target_attribute = "userName"
+}`, testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name), environmentName, resourceName)
+}
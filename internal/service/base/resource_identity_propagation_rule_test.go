@@ -0,0 +1,319 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	baselegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base/legacysdk"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccIdentityPropagationRule_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var propagationRuleID, propagationPlanID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRule_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Check:  base.IdentityPropagationRule_GetIDs(resourceFullName, &environmentID, &propagationRuleID),
+			},
+			{
+				PreConfig: func() {
+					base.IdentityPropagationRule_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, propagationRuleID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the plan
+			{
+				Config: testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					base.IdentityPropagationRule_GetIDs(resourceFullName, &environmentID, &propagationRuleID),
+					base.IdentityPropagationPlan_GetIDs(fmt.Sprintf("pingone_identity_propagation_plan.%s", resourceName), nil, &propagationPlanID),
+				),
+			},
+			{
+				PreConfig: func() {
+					base.IdentityPropagationPlan_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, propagationPlanID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the environment
+			{
+				Config: testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Check:  base.IdentityPropagationRule_GetIDs(resourceFullName, &environmentID, &propagationRuleID),
+			},
+			{
+				PreConfig: func() {
+					baselegacysdk.Environment_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationRule_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	fullStep := resource.TestStep{
+		Config: testAccIdentityPropagationRuleConfig_Full(environmentName, licenseID, resourceName, name),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttrPair(resourceFullName, "plan_id", fmt.Sprintf("pingone_identity_propagation_plan.%s", resourceName), "id"),
+			resource.TestCheckResourceAttrPair(resourceFullName, "source_store_id", fmt.Sprintf("pingone_identity_propagation_store.%s-source", resourceName), "id"),
+			resource.TestCheckResourceAttrPair(resourceFullName, "target_store_id", fmt.Sprintf("pingone_identity_propagation_store.%s-target", resourceName), "id"),
+			resource.TestCheckResourceAttr(resourceFullName, "active", "false"),
+			resource.TestCheckResourceAttr(resourceFullName, "deprovision", "true"),
+			resource.TestCheckResourceAttr(resourceFullName, "filter", "email ew \"@bxretail.org\""),
+			resource.TestCheckResourceAttr(resourceFullName, "population_ids.#", "1"),
+			resource.TestCheckResourceAttr(resourceFullName, "group_ids.#", "1"),
+		),
+	}
+
+	minimalStep := resource.TestStep{
+		Config: testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttrPair(resourceFullName, "plan_id", fmt.Sprintf("pingone_identity_propagation_plan.%s", resourceName), "id"),
+			resource.TestCheckResourceAttr(resourceFullName, "active", "true"),
+			resource.TestCheckResourceAttr(resourceFullName, "deprovision", "false"),
+			resource.TestCheckNoResourceAttr(resourceFullName, "filter"),
+			resource.TestCheckNoResourceAttr(resourceFullName, "population_ids"),
+			resource.TestCheckNoResourceAttr(resourceFullName, "group_ids"),
+		),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRule_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Full
+			fullStep,
+			{
+				Config:  testAccIdentityPropagationRuleConfig_Full(environmentName, licenseID, resourceName, name),
+				Destroy: true,
+			},
+			// Minimal
+			minimalStep,
+			{
+				Config:  testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Destroy: true,
+			},
+			// Change
+			fullStep,
+			minimalStep,
+			fullStep,
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.ID), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationRule_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_rule.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationRule_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccIdentityPropagationRuleConfig_Base(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_plan" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_identity_propagation_store" "%[3]s-source" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s-source"
+  type = "directory"
+
+  configuration = jsonencode({})
+}
+
+resource "pingone_identity_propagation_store" "%[3]s-target" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s-target"
+  type = "scim"
+
+  configuration = jsonencode({
+    SCIM_URL               = "https://scim.bxretail.org/scim/v2"
+    SCIM_VERSION           = "2.0"
+    AUTHENTICATION_METHOD  = "OAuth 2 Bearer Token"
+    AUTHORIZATION_TYPE     = "Bearer"
+    OAUTH_ACCESS_TOKEN     = "dummyaccesstoken"
+    OAUTH_TOKEN_REQUEST    = "https://scim.bxretail.org/as/token"
+    UNIQUE_USER_IDENTIFIER = "userName"
+    USER_FILTER            = "userName eq \"%%s\""
+    USERS_RESOURCE         = "/Users"
+  })
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
+
+func testAccIdentityPropagationRuleConfig_Full(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_population" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_group" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_identity_propagation_rule" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  plan_id         = pingone_identity_propagation_plan.%[3]s.id
+  source_store_id = pingone_identity_propagation_store.%[3]s-source.id
+  target_store_id = pingone_identity_propagation_store.%[3]s-target.id
+
+  active      = false
+  deprovision = true
+  filter      = "email ew \"@bxretail.org\""
+
+  population_ids = [
+    pingone_population.%[3]s.id,
+  ]
+
+  group_ids = [
+    pingone_group.%[3]s.id,
+  ]
+}`, testAccIdentityPropagationRuleConfig_Base(environmentName, licenseID, resourceName, name), environmentName, resourceName, name)
+}
+
+func testAccIdentityPropagationRuleConfig_Minimal(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_rule" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  plan_id         = pingone_identity_propagation_plan.%[3]s.id
+  source_store_id = pingone_identity_propagation_store.%[3]s-source.id
+  target_store_id = pingone_identity_propagation_store.%[3]s-target.id
+}`, testAccIdentityPropagationRuleConfig_Base(environmentName, licenseID, resourceName, name), environmentName, resourceName, name)
+}
@@ -0,0 +1,452 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/jsontypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/utils"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// Types
+type IdentityPropagationStoreResource serviceClientType
+
+type identityPropagationStoreResourceModel struct {
+	Id            pingonetypes.ResourceIDValue     `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue     `tfsdk:"environment_id"`
+	Name          types.String                     `tfsdk:"name"`
+	Description   types.String                     `tfsdk:"description"`
+	Type          types.String                     `tfsdk:"type"`
+	Configuration jsontypes.NormalizedObfuscatable `tfsdk:"configuration"`
+	Managed       types.Bool                       `tfsdk:"managed"`
+	Status        types.String                     `tfsdk:"status"`
+}
+
+// Framework interfaces
+var (
+	_ resource.Resource                = &IdentityPropagationStoreResource{}
+	_ resource.ResourceWithConfigure   = &IdentityPropagationStoreResource{}
+	_ resource.ResourceWithImportState = &IdentityPropagationStoreResource{}
+)
+
+// New Object
+func NewIdentityPropagationStoreResource() resource.Resource {
+	return &IdentityPropagationStoreResource{}
+}
+
+// Metadata
+func (r *IdentityPropagationStoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_propagation_store"
+}
+
+// Schema.
+func (r *IdentityPropagationStoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the name of the identity propagation store.",
+	)
+
+	descriptionDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the description of the identity propagation store.",
+	)
+
+	typeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the type of the identity propagation store.",
+	).AllowedValuesEnum(management.AllowedEnumPropagationStoreTypeEnumValues).RequiresReplace()
+
+	configurationDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A JSON string that specifies the configuration properties of the identity propagation store.  The properties that apply depend on the store `type`; for example, a `scim` store is configured with properties such as `SCIM_URL`, `AUTHENTICATION_METHOD` and `OAUTH_ACCESS_TOKEN`.  Secret values are returned obfuscated by the service, so changes to secrets made outside of Terraform are not detected.",
+	)
+
+	managedDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that specifies whether users provisioned to the store are deprovisioned when the store is deleted.  The deprovisioning occurs when the next propagation revision is created.",
+	).DefaultValue(false)
+
+	statusDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the status of the identity propagation store.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to create and manage PingOne identity propagation (provisioning) stores for an environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to manage the identity propagation store in."),
+			),
+
+			"name": schema.StringAttribute{
+				Description:         nameDescription.Description,
+				MarkdownDescription: nameDescription.MarkdownDescription,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"description": schema.StringAttribute{
+				Description:         descriptionDescription.Description,
+				MarkdownDescription: descriptionDescription.MarkdownDescription,
+				Optional:            true,
+			},
+
+			"type": schema.StringAttribute{
+				Description:         typeDescription.Description,
+				MarkdownDescription: typeDescription.MarkdownDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.EnumSliceToStringSlice(management.AllowedEnumPropagationStoreTypeEnumValues)...),
+				},
+			},
+
+			"configuration": schema.StringAttribute{
+				Description:         configurationDescription.Description,
+				MarkdownDescription: configurationDescription.MarkdownDescription,
+				Required:            true,
+				Sensitive:           true,
+
+				CustomType: jsontypes.NormalizedObfuscatableType{},
+			},
+
+			"managed": schema.BoolAttribute{
+				Description:         managedDescription.Description,
+				MarkdownDescription: managedDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				Default: booldefault.StaticBool(false),
+			},
+
+			"status": schema.StringAttribute{
+				Description:         statusDescription.Description,
+				MarkdownDescription: statusDescription.MarkdownDescription,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *IdentityPropagationStoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *IdentityPropagationStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state identityPropagationStoreResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	propagationStore, d := plan.expand()
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *management.PropagationStore
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.ManagementAPIClient.PropagationStoresApi.CreatePropagationStore(ctx, plan.EnvironmentId.ValueString()).PropagationStore(*propagationStore).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"CreatePropagationStore",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IdentityPropagationStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *identityPropagationStoreResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *management.PropagationStore
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.ManagementAPIClient.PropagationStoresApi.ReadOnePropagationStore(ctx, data.EnvironmentId.ValueString(), data.Id.ValueString()).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOnePropagationStore",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if response == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdentityPropagationStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state identityPropagationStoreResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	propagationStore, d := plan.expand()
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *management.PropagationStore
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.ManagementAPIClient.PropagationStoresApi.UpdatePropagationStore(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString()).PropagationStore(*propagationStore).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"UpdatePropagationStore",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *IdentityPropagationStoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *identityPropagationStoreResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := r.Client.ManagementAPIClient.PropagationStoresApi.DeletePropagationStore(ctx, data.EnvironmentId.ValueString(), data.Id.ValueString()).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, fR, fErr)
+		},
+		"DeletePropagationStore",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		nil,
+		nil,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdentityPropagationStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "identity_propagation_store_id",
+			Regexp:    verify.P1ResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathIdentityPropagationStore := idComponent.Label
+
+		if idComponent.PrimaryID {
+			pathIdentityPropagationStore = "id"
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathIdentityPropagationStore), attributes[idComponent.Label])...)
+	}
+}
+
+func (p *identityPropagationStoreResourceModel) expand() (*management.PropagationStore, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var configuration map[string]interface{}
+	if err := json.Unmarshal([]byte(p.Configuration.ValueString()), &configuration); err != nil {
+		diags.AddAttributeError(
+			path.Root("configuration"),
+			"Invalid store configuration",
+			fmt.Sprintf("The store configuration must be a JSON object: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	data := management.NewPropagationStore(
+		configuration,
+		p.Name.ValueString(),
+		management.EnumPropagationStoreType(p.Type.ValueString()),
+	)
+
+	if !p.Description.IsNull() && !p.Description.IsUnknown() {
+		data.SetDescription(p.Description.ValueString())
+	}
+
+	if !p.Managed.IsNull() && !p.Managed.IsUnknown() {
+		data.SetManaged(p.Managed.ValueBool())
+	}
+
+	return data, diags
+}
+
+func (p *identityPropagationStoreResourceModel) toState(apiObject *management.PropagationStore) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDOkToTF(apiObject.GetIdOk())
+	p.EnvironmentId = framework.PingOneResourceIDToTF(*apiObject.GetEnvironment().Id)
+	p.Name = framework.StringOkToTF(apiObject.GetNameOk())
+	p.Description = framework.StringOkToTF(apiObject.GetDescriptionOk())
+	p.Type = framework.EnumOkToTF(apiObject.GetTypeOk())
+	p.Status = framework.EnumOkToTF(apiObject.GetStatusOk())
+
+	if v, ok := apiObject.GetManagedOk(); ok {
+		p.Managed = framework.BoolOkToTF(v, ok)
+	} else {
+		p.Managed = types.BoolValue(false)
+	}
+
+	p.Configuration, d = identityPropagationStoreConfigurationToTF(p.Configuration, apiObject.GetConfiguration())
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,269 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package base_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base"
+	baselegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base/legacysdk"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccIdentityPropagationStore_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_store.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var propagationStoreID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationStore_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccIdentityPropagationStoreConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Check:  base.IdentityPropagationStore_GetIDs(resourceFullName, &environmentID, &propagationStoreID),
+			},
+			{
+				PreConfig: func() {
+					base.IdentityPropagationStore_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, propagationStoreID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the environment
+			{
+				Config: testAccIdentityPropagationStoreConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Check:  base.IdentityPropagationStore_GetIDs(resourceFullName, &environmentID, &propagationStoreID),
+			},
+			{
+				PreConfig: func() {
+					baselegacysdk.Environment_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationStore_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_store.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	fullStep := resource.TestStep{
+		Config: testAccIdentityPropagationStoreConfig_Full(environmentName, licenseID, resourceName, name),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttr(resourceFullName, "name", name),
+			resource.TestCheckResourceAttr(resourceFullName, "description", "Test SCIM store"),
+			resource.TestCheckResourceAttr(resourceFullName, "type", "scim"),
+			resource.TestCheckResourceAttrSet(resourceFullName, "configuration"),
+			resource.TestCheckResourceAttr(resourceFullName, "managed", "true"),
+			resource.TestCheckResourceAttrSet(resourceFullName, "status"),
+		),
+	}
+
+	minimalStep := resource.TestStep{
+		Config: testAccIdentityPropagationStoreConfig_Minimal(environmentName, licenseID, resourceName, name),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttr(resourceFullName, "name", name),
+			resource.TestCheckNoResourceAttr(resourceFullName, "description"),
+			resource.TestCheckResourceAttr(resourceFullName, "type", "scim"),
+			resource.TestCheckResourceAttrSet(resourceFullName, "configuration"),
+			resource.TestCheckResourceAttr(resourceFullName, "managed", "false"),
+		),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationStore_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Full
+			fullStep,
+			{
+				Config:  testAccIdentityPropagationStoreConfig_Full(environmentName, licenseID, resourceName, name),
+				Destroy: true,
+			},
+			// Minimal
+			minimalStep,
+			{
+				Config:  testAccIdentityPropagationStoreConfig_Minimal(environmentName, licenseID, resourceName, name),
+				Destroy: true,
+			},
+			// Change
+			fullStep,
+			minimalStep,
+			fullStep,
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.ID), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"configuration",
+				},
+			},
+		},
+	})
+}
+
+func TestAccIdentityPropagationStore_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_identity_propagation_store.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             base.IdentityPropagationStore_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccIdentityPropagationStoreConfig_Minimal(environmentName, licenseID, resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccIdentityPropagationStoreConfig_Full(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_store" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name        = "%[4]s"
+  description = "Test SCIM store"
+  type        = "scim"
+  managed     = true
+
+  configuration = jsonencode({
+    SCIM_URL               = "https://scim.bxretail.org/scim/v2"
+    SCIM_VERSION           = "2.0"
+    AUTHENTICATION_METHOD  = "OAuth 2 Bearer Token"
+    AUTHORIZATION_TYPE     = "Bearer"
+    OAUTH_ACCESS_TOKEN     = "dummyaccesstoken"
+    OAUTH_TOKEN_REQUEST    = "https://scim.bxretail.org/as/token"
+    UNIQUE_USER_IDENTIFIER = "userName"
+    USER_FILTER            = "userName eq \"%%s\""
+    USERS_RESOURCE         = "/Users"
+    CREATE_USERS           = true
+    UPDATE_USERS           = true
+    DISABLE_USERS          = true
+    REMOVE_ACTION          = "Disable"
+  })
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
+
+func testAccIdentityPropagationStoreConfig_Minimal(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_identity_propagation_store" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+  type = "scim"
+
+  configuration = jsonencode({
+    SCIM_URL               = "https://scim.bxretail.org/scim/v2"
+    SCIM_VERSION           = "2.0"
+    AUTHENTICATION_METHOD  = "Basic Authentication"
+    AUTHORIZATION_TYPE     = "Basic"
+    BASIC_AUTH_USER        = "provisioner"
+    BASIC_AUTH_PASSWORD    = "dummypassword"
+    UNIQUE_USER_IDENTIFIER = "userName"
+    USER_FILTER            = "userName eq \"%%s\""
+    USERS_RESOURCE         = "/Users"
+  })
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
@@ -32,6 +32,9 @@ func Resources() []func() resource.Resource {
 		NewGatewayResource,
 		NewGatewayRoleAssignmentResource,
 		NewIdentityPropagationPlanResource,
+		NewIdentityPropagationRuleMappingResource,
+		NewIdentityPropagationRuleResource,
+		NewIdentityPropagationStoreResource,
 		NewImageResource,
 		NewKeyResource,
 		NewKeyRotationPolicyResource,
@@ -58,12 +61,22 @@ func DataSources() []func() datasource.DataSource {
 	dataSources := []func() datasource.DataSource{
 		NewAgreementDataSource,
 		NewAgreementLocalizationDataSource,
+		NewBrandingThemeDataSource,
+		NewBrandingThemesDataSource,
 		NewEnvironmentDataSource,
 		NewEnvironmentsDataSource,
+		NewFormDataSource,
+		NewFormsDataSource,
+		NewFormsRecaptchaV2DataSource,
 		NewGatewayDataSource,
+		NewGatewayInstancesDataSource,
+		NewIdentityPropagationRuleDataSource,
+		NewIdentityPropagationRuleMappingDataSource,
+		NewIdentityPropagationStoreDataSource,
 		NewLicenseDataSource,
 		NewLicensesDataSource,
 		NewNotificationPolicyDataSource,
+		NewNotificationTemplateContentsDataSource,
 		NewOrganizationDataSource,
 		NewPhoneDeliverySettingsListDataSource,
 		NewRoleDataSource,
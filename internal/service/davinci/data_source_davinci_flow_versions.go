@@ -0,0 +1,240 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/pingone-go-client/pingone"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+)
+
+var (
+	_ datasource.DataSource              = &davinciFlowVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &davinciFlowVersionsDataSource{}
+)
+
+func NewDavinciFlowVersionsDataSource() datasource.DataSource {
+	return &davinciFlowVersionsDataSource{}
+}
+
+type davinciFlowVersionsDataSource serviceClientType
+
+func (r *davinciFlowVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_davinci_flow_versions"
+}
+
+func (r *davinciFlowVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(framework.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+type davinciFlowVersionsDataSourceModel struct {
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	FlowId        types.String `tfsdk:"flow_id"`
+	Id            types.String `tfsdk:"id"`
+	Versions      types.List   `tfsdk:"versions"`
+}
+
+var davinciFlowVersionsAttrTypes = map[string]attr.Type{
+	"alias":       types.StringType,
+	"cloned_from": types.Float32Type,
+	"created_at":  timetypes.RFC3339Type{},
+	"deployed_at": timetypes.RFC3339Type{},
+	"updated_at":  timetypes.RFC3339Type{},
+	"version":     types.Float32Type,
+}
+
+func (r *davinciFlowVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to retrieve the version history of a DaVinci flow.",
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the environment containing the DaVinci flow. Must be a valid PingOne resource ID.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"), "Must be a valid UUID"),
+				},
+			},
+			"flow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the DaVinci flow to read the version history of.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Description:         "The versions of the flow, ordered from the oldest to the most recent version.",
+				MarkdownDescription: "The versions of the flow, ordered from the oldest to the most recent version.  The `version` values can be used in the `version` attribute of the `pingone_davinci_flow_deploy` resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.StringAttribute{
+							Description: "The alias of the version.",
+							Computed:    true,
+						},
+						"cloned_from": schema.Float32Attribute{
+							Description: "The version that this version was cloned from, if any.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the version was created.",
+							CustomType:  timetypes.RFC3339Type{},
+							Computed:    true,
+						},
+						"deployed_at": schema.StringAttribute{
+							Description: "The time the version was last deployed, if it has been deployed.",
+							CustomType:  timetypes.RFC3339Type{},
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The time the version was last updated.",
+							CustomType:  timetypes.RFC3339Type{},
+							Computed:    true,
+						},
+						"version": schema.Float32Attribute{
+							Description: "The version number.",
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (state *davinciFlowVersionsDataSourceModel) readClientResponse(response *pingone.DaVinciFlowVersionCollectionResponse) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	versions := slices.Clone(response.Embedded.Versions)
+	slices.SortFunc(versions, func(a, b pingone.DaVinciFlowVersionResponse) int {
+		switch {
+		case a.Version < b.Version:
+			return -1
+		case a.Version > b.Version:
+			return 1
+		}
+		return 0
+	})
+
+	versionsElementType := types.ObjectType{AttrTypes: davinciFlowVersionsAttrTypes}
+	var versionsValues []attr.Value
+	for _, version := range versions {
+		versionValue, diags := types.ObjectValue(davinciFlowVersionsAttrTypes, map[string]attr.Value{
+			"alias":       types.StringPointerValue(version.Alias),
+			"cloned_from": types.Float32PointerValue(version.ClonedFrom),
+			"created_at":  davinciFlowVersionTimeValue(version.CreatedAt),
+			"deployed_at": davinciFlowVersionTimeValue(version.DeployedAt),
+			"updated_at":  davinciFlowVersionTimeValue(version.UpdatedAt),
+			"version":     types.Float32Value(version.Version),
+		})
+		respDiags.Append(diags...)
+		versionsValues = append(versionsValues, versionValue)
+	}
+	versionsValue, diags := types.ListValue(versionsElementType, versionsValues)
+	respDiags.Append(diags...)
+	state.Versions = versionsValue
+	// id
+	state.Id = types.StringValue(uuid.New().String())
+	return respDiags
+}
+
+func davinciFlowVersionTimeValue(t *time.Time) timetypes.RFC3339 {
+	if t == nil {
+		return timetypes.NewRFC3339Null()
+	}
+
+	return timetypes.NewRFC3339TimeValue(*t)
+}
+
+func (r *davinciFlowVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data davinciFlowVersionsDataSourceModel
+
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	environmentIdUuid, err := uuid.Parse(data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.EnvironmentId.ValueString(), "EnvironmentId", err.Error()),
+		)
+		return
+	}
+	var responseData *pingone.DaVinciFlowVersionCollectionResponse
+	resp.Diagnostics.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciFlowVersionsApi.GetVersionsByFlowId(ctx, environmentIdUuid, data.FlowId.ValueString()).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"GetVersionsByFlowId",
+		framework.DefaultCustomError,
+		framework.DefaultCreateReadRetryable,
+		&responseData,
+	)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+)
+
+func TestAccDavinciFlowVersionsDataSource_Get(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_davinci_flow_versions.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciFlow_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciFlowVersionsDataSource_HCL(t, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "versions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "versions.0.version"),
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "versions.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func davinciFlowVersionsDataSource_HCL(t *testing.T, resourceName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+data "pingone_davinci_flow_versions" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  flow_id        = pingone_davinci_flow_deploy.%[2]s.flow_id
+}
+`, davinciFlowDeploy_FirstDeployHCL(t, resourceName, false), resourceName)
+}
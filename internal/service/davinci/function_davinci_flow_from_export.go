@@ -0,0 +1,132 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/pingone-go-client/pingone"
+)
+
+// Types
+type davinciFlowFromExportFunction struct{}
+
+// Framework interfaces
+var (
+	_ function.Function = &davinciFlowFromExportFunction{}
+)
+
+// The pingone_davinci_flow attributes that can be sourced from a flow export
+var davinciFlowFromExportAttributes = []string{
+	"color",
+	"description",
+	"graph_data",
+	"input_schema",
+	"name",
+	"output_schema",
+	"settings",
+	"trigger",
+}
+
+// New Object
+func NewDavinciFlowFromExportFunction() function.Function {
+	return &davinciFlowFromExportFunction{}
+}
+
+// Metadata
+func (f *davinciFlowFromExportFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "davinci_flow_from_export"
+}
+
+// Definition
+func (f *davinciFlowFromExportFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts a DaVinci flow export into pingone_davinci_flow attribute values.",
+		Description:         "Parses the JSON of a DaVinci flow export and returns an object containing the \"color\", \"description\", \"graph_data\", \"input_schema\", \"name\", \"output_schema\", \"settings\" and \"trigger\" values, in the same shape as the equivalent \"pingone_davinci_flow\" resource attributes.  Node \"properties\" are normalized in the same way as when the flow is read from the service.  When the export includes subflows, only the parent flow is returned.",
+		MarkdownDescription: "Parses the JSON of a DaVinci flow export and returns an object containing the `color`, `description`, `graph_data`, `input_schema`, `name`, `output_schema`, `settings` and `trigger` values, in the same shape as the equivalent `pingone_davinci_flow` resource attributes.  Node `properties` are normalized in the same way as when the flow is read from the service.  When the export includes subflows, only the parent flow is returned.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "export_json",
+				Description:         "The JSON string of the DaVinci flow export, for example as loaded with the \"file\" function.",
+				MarkdownDescription: "The JSON string of the DaVinci flow export, for example as loaded with the `file` function.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: davinciFlowFromExportAttrTypes(ctx),
+		},
+	}
+}
+
+// Run
+func (f *davinciFlowFromExportFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var exportJson string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &exportJson))
+	if resp.Error != nil {
+		return
+	}
+
+	// The subflows export is a superset of the single flow export, so both formats can be read with it
+	var export pingone.DaVinciExportFlowVersionSubflowsResponse
+	if err := json.Unmarshal([]byte(exportJson), &export); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse the DaVinci flow export: %s", err.Error()))
+		return
+	}
+
+	flowResponse := &pingone.DaVinciFlowResponse{
+		Id:           export.Flow.Id,
+		Name:         export.Flow.Name,
+		Color:        export.Color,
+		Description:  export.Description,
+		GraphData:    export.GraphData,
+		InputSchema:  export.InputSchema,
+		OutputSchema: export.OutputSchema,
+		Settings:     export.Settings,
+		Trigger:      export.Trigger,
+	}
+
+	// No graph_data is planned, so all node properties from the export are retained
+	var data davinciFlowResourceModel
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, data.readClientResponse(flowResponse)))
+	if resp.Error != nil {
+		return
+	}
+
+	result, diags := types.ObjectValue(davinciFlowFromExportAttrTypes(ctx), map[string]attr.Value{
+		"color":         data.Color,
+		"description":   data.Description,
+		"graph_data":    data.GraphData,
+		"input_schema":  data.InputSchema,
+		"name":          data.Name,
+		"output_schema": data.OutputSchema,
+		"settings":      data.Settings,
+		"trigger":       data.Trigger,
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// davinciFlowFromExportAttrTypes returns the return object attribute types, taken from the pingone_davinci_flow
+// resource schema so that the function result can be assigned directly to the resource attributes
+func davinciFlowFromExportAttrTypes(ctx context.Context) map[string]attr.Type {
+	schemaResp := resource.SchemaResponse{}
+	(&davinciFlowResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	attrTypes := make(map[string]attr.Type, len(davinciFlowFromExportAttributes))
+	for _, attribute := range davinciFlowFromExportAttributes {
+		attrTypes[attribute] = schemaResp.Schema.Attributes[attribute].GetType()
+	}
+
+	return attrTypes
+}
@@ -0,0 +1,336 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccDavinciFlowFromExportFunction_Values(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoBeta(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciFlowFromExportFunction_ValuesHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("name", "Exported Flow"),
+					resource.TestCheckOutput("description", "A flow exported from DaVinci"),
+					resource.TestCheckOutput("color", "#FFC8C1"),
+					resource.TestCheckOutput("node_count", "2"),
+					resource.TestCheckOutput("edge_count", "1"),
+					resource.TestCheckOutput("node_connector_id", "errorConnector"),
+					resource.TestCheckOutput("node_properties", `{"error_description":{"value":"This is an error, really"},"error_message":{"value":"This is an error"}}`),
+					resource.TestCheckOutput("edge_source", "2pzouq7el7"),
+					resource.TestCheckOutput("edge_target", "8n3jzbt4a1"),
+					resource.TestCheckOutput("log_level", "1"),
+					resource.TestCheckOutput("trigger_is_null", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDavinciFlowFromExportFunction_Flow(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_flow.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciFlow_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciFlowFromExportFunction_FlowHCL(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1DVResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(resourceFullName, "name", "Exported Flow"),
+					resource.TestCheckResourceAttr(resourceFullName, "description", "A flow exported from DaVinci"),
+					resource.TestCheckResourceAttr(resourceFullName, "color", "#FFC8C1"),
+					resource.TestCheckResourceAttr(resourceFullName, "graph_data.elements.nodes.%", "2"),
+					resource.TestCheckResourceAttr(resourceFullName, "graph_data.elements.edges.%", "1"),
+					resource.TestCheckResourceAttrPair(resourceFullName, "graph_data.elements.nodes.2pzouq7el7.data.connection_id", fmt.Sprintf("pingone_davinci_connector_instance.%s-errors", resourceName), "id"),
+					resource.TestCheckResourceAttr(resourceFullName, "settings.log_level", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDavinciFlowFromExportFunction_InvalidExport(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoBeta(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      davinciFlowFromExportFunction_OutputHCL(`"not json"`),
+				ExpectError: regexp.MustCompile(`Unable to parse the DaVinci flow export`),
+			},
+			{
+				Config:      davinciFlowFromExportFunction_OutputHCL(`jsonencode({ "name" = "missing export fields" })`),
+				ExpectError: regexp.MustCompile(`Unable to parse the DaVinci flow export`),
+			},
+		},
+	})
+}
+
+func davinciFlowFromExportFunction_ValuesHCL() string {
+	return fmt.Sprintf(`
+locals {
+  flow = provider::pingone::davinci_flow_from_export(%[1]s)
+}
+
+output "name" {
+  value = local.flow.name
+}
+
+output "description" {
+  value = local.flow.description
+}
+
+output "color" {
+  value = local.flow.color
+}
+
+output "node_count" {
+  value = length(local.flow.graph_data.elements.nodes)
+}
+
+output "edge_count" {
+  value = length(local.flow.graph_data.elements.edges)
+}
+
+output "node_connector_id" {
+  value = local.flow.graph_data.elements.nodes["2pzouq7el7"].data.connector_id
+}
+
+output "node_properties" {
+  value = local.flow.graph_data.elements.nodes["2pzouq7el7"].data.properties
+}
+
+output "edge_source" {
+  value = local.flow.graph_data.elements.edges["ms8ahj3a1j"].data.source
+}
+
+output "edge_target" {
+  value = local.flow.graph_data.elements.edges["ms8ahj3a1j"].data.target
+}
+
+output "log_level" {
+  value = local.flow.settings.log_level
+}
+
+output "trigger_is_null" {
+  value = local.flow.trigger == null
+}`, davinciFlowFromExportFunction_ExportJSON("c7d9b5a5f2b34b1d8d5f4e0e2e6c6c8a"))
+}
+
+func davinciFlowFromExportFunction_FlowHCL(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_davinci_connector_instance" "%[2]s-errors" {
+  environment_id = data.pingone_environment.general_test.id
+  connector = {
+    id = "errorConnector"
+  }
+  name = "%[2]s-errors"
+}
+
+locals {
+  %[2]s_flow = provider::pingone::davinci_flow_from_export(%[3]s)
+}
+
+resource "pingone_davinci_flow" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name          = local.%[2]s_flow.name
+  description   = local.%[2]s_flow.description
+  color         = local.%[2]s_flow.color
+  graph_data    = local.%[2]s_flow.graph_data
+  input_schema  = local.%[2]s_flow.input_schema
+  output_schema = local.%[2]s_flow.output_schema
+  settings      = local.%[2]s_flow.settings
+  trigger       = local.%[2]s_flow.trigger
+}`, acctest.GenericSandboxEnvironment(), resourceName, davinciFlowFromExportFunction_ExportJSON(fmt.Sprintf("${pingone_davinci_connector_instance.%s-errors.id}", resourceName)))
+}
+
+func davinciFlowFromExportFunction_OutputHCL(exportJson string) string {
+	return fmt.Sprintf(`
+output "flow" {
+  value = provider::pingone::davinci_flow_from_export(%[1]s)
+}`, exportJson)
+}
+
+// davinciFlowFromExportFunction_ExportJSON returns a flow export as a heredoc string, with the connection ID of the error node set
+func davinciFlowFromExportFunction_ExportJSON(errorConnectionId string) string {
+	return fmt.Sprintf(`<<EOT
+{
+  "_links": {
+    "environment": {
+      "href": "https://api.pingone.com/v1/environments/00000000-0000-0000-0000-000000000000"
+    },
+    "self": {
+      "href": "https://api.pingone.com/v1/environments/00000000-0000-0000-0000-000000000000/flows/4c4b0b3e8b1b4a2e9b1c0d6f5e3a2b1c/versions/1"
+    }
+  },
+  "environment": {
+    "id": "00000000-0000-0000-0000-000000000000"
+  },
+  "flow": {
+    "id": "4c4b0b3e8b1b4a2e9b1c0d6f5e3a2b1c",
+    "name": "Exported Flow"
+  },
+  "publishedVersion": 1,
+  "version": 1,
+  "color": "#FFC8C1",
+  "description": "A flow exported from DaVinci",
+  "graphData": {
+    "elements": {
+      "nodes": [
+        {
+          "data": {
+            "id": "2pzouq7el7",
+            "nodeType": "CONNECTION",
+            "connectionId": "%[1]s",
+            "connectorId": "errorConnector",
+            "name": "Error Message",
+            "label": "Error Message",
+            "status": "configured",
+            "capabilityName": "customErrorMessage",
+            "type": "action",
+            "properties": {
+              "error_message": {
+                "value": "This is an error"
+              },
+              "error_description": {
+                "value": "This is an error, really"
+              }
+            }
+          },
+          "position": {
+            "x": 400,
+            "y": 400
+          },
+          "group": "nodes",
+          "removed": false,
+          "selected": false,
+          "selectable": true,
+          "locked": false,
+          "grabbable": true,
+          "pannable": false,
+          "classes": ""
+        },
+        {
+          "data": {
+            "id": "8n3jzbt4a1",
+            "nodeType": "CONNECTION",
+            "connectionId": "%[1]s",
+            "connectorId": "errorConnector",
+            "name": "Error Message",
+            "label": "Error Message",
+            "status": "configured",
+            "capabilityName": "customErrorMessage",
+            "type": "action",
+            "properties": {
+              "error_message": {
+                "value": "This is another error"
+              }
+            }
+          },
+          "position": {
+            "x": 600,
+            "y": 400
+          },
+          "group": "nodes",
+          "removed": false,
+          "selected": false,
+          "selectable": true,
+          "locked": false,
+          "grabbable": true,
+          "pannable": false,
+          "classes": ""
+        }
+      ],
+      "edges": [
+        {
+          "data": {
+            "id": "ms8ahj3a1j",
+            "source": "2pzouq7el7",
+            "target": "8n3jzbt4a1"
+          },
+          "position": {
+            "x": 0,
+            "y": 0
+          },
+          "group": "edges",
+          "removed": false,
+          "selected": false,
+          "selectable": true,
+          "locked": false,
+          "grabbable": true,
+          "pannable": true,
+          "classes": ""
+        }
+      ]
+    },
+    "data": {},
+    "zoomingEnabled": true,
+    "userZoomingEnabled": true,
+    "zoom": 1,
+    "minZoom": 1e-50,
+    "maxZoom": 1e50,
+    "panningEnabled": true,
+    "userPanningEnabled": true,
+    "pan": {
+      "x": 0,
+      "y": 0
+    },
+    "boxSelectionEnabled": true,
+    "renderer": {
+      "name": "null"
+    }
+  },
+  "settings": {
+    "csp": "worker-src 'self' blob:; script-src 'self' 'unsafe-inline' 'unsafe-eval';",
+    "flowHttpTimeoutInSeconds": 300,
+    "logLevel": 1,
+    "useCustomCSS": true
+  }
+}
+EOT
+`, errorConnectionId)
+}
@@ -0,0 +1,281 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// davinciFlowGraph is a simplified view of a flow's graph_data, used to render the flow as a diagram
+type davinciFlowGraph struct {
+	Nodes []davinciFlowGraphNode
+	Edges []davinciFlowGraphEdge
+}
+
+type davinciFlowGraphNode struct {
+	Key      string
+	NodeType string
+	Label    string
+}
+
+type davinciFlowGraphEdge struct {
+	Key    string
+	Source string
+	Target string
+	Label  string
+}
+
+// davinciFlowGraphParameter returns the graph_data parameter shared by the flow graph functions
+func davinciFlowGraphParameter(ctx context.Context) function.Parameter {
+	return function.ObjectParameter{
+		Name:                "graph_data",
+		Description:         "The \"graph_data\" value of a \"pingone_davinci_flow\" resource, or of the object returned by the \"davinci_flow_from_export\" function.",
+		MarkdownDescription: "The `graph_data` value of a `pingone_davinci_flow` resource, or of the object returned by the `davinci_flow_from_export` function.",
+		AttributeTypes:      davinciFlowGraphDataAttrTypes(ctx),
+	}
+}
+
+// davinciFlowGraphDataAttrTypes returns the attribute types of the pingone_davinci_flow graph_data attribute
+func davinciFlowGraphDataAttrTypes(ctx context.Context) map[string]attr.Type {
+	return davinciFlowFromExportAttrTypes(ctx)["graph_data"].(types.ObjectType).AttrTypes
+}
+
+// newDavinciFlowGraph builds the diagram view of a graph_data object.  Annotation nodes are omitted, as they are
+// comments on the flow canvas rather than steps of the flow.  Nodes and edges are sorted by key so that the
+// rendered output is stable.  An error is returned against the graph_data argument where a node or edge is missing
+// a value needed to render it.
+func newDavinciFlowGraph(graphData types.Object) (davinciFlowGraph, *function.FuncError) {
+	var graph davinciFlowGraph
+
+	if graphData.IsNull() || graphData.IsUnknown() {
+		return graph, nil
+	}
+
+	elements, ok := graphData.Attributes()["elements"].(types.Object)
+	if !ok || elements.IsNull() || elements.IsUnknown() {
+		return graph, nil
+	}
+
+	nodeData := map[string]map[string]attr.Value{}
+	if nodes, ok := elements.Attributes()["nodes"].(types.Map); ok {
+		for nodeKey, node := range nodes.Elements() {
+			data := graphDataNodeDataAttributes(node)
+			if data == nil {
+				return graph, function.NewArgumentFuncError(0, fmt.Sprintf("The node %q of graph_data.elements.nodes has no data value.", nodeKey))
+			}
+
+			nodeType, ok := davinciFlowGraphStringAttribute(data, "node_type")
+			if !ok {
+				return graph, function.NewArgumentFuncError(0, fmt.Sprintf("The node %q of graph_data.elements.nodes has no data.node_type value.", nodeKey))
+			}
+
+			if nodeType == "ANNOTATION" {
+				continue
+			}
+			nodeData[nodeKey] = data
+		}
+	}
+
+	for _, nodeKey := range slices.Sorted(maps.Keys(nodeData)) {
+		data := nodeData[nodeKey]
+		nodeType, _ := davinciFlowGraphStringAttribute(data, "node_type")
+		graph.Nodes = append(graph.Nodes, davinciFlowGraphNode{
+			Key:      nodeKey,
+			NodeType: nodeType,
+			Label:    davinciFlowGraphNodeLabel(data),
+		})
+	}
+
+	edges, ok := elements.Attributes()["edges"].(types.Map)
+	if !ok {
+		return graph, nil
+	}
+
+	for _, edgeKey := range slices.Sorted(maps.Keys(edges.Elements())) {
+		edge, ok := edges.Elements()[edgeKey].(types.Object)
+		if !ok || edge.IsNull() || edge.IsUnknown() {
+			continue
+		}
+
+		edgeData, ok := edge.Attributes()["data"].(types.Object)
+		if !ok || edgeData.IsNull() || edgeData.IsUnknown() {
+			return graph, function.NewArgumentFuncError(0, fmt.Sprintf("The edge %q of graph_data.elements.edges has no data value.", edgeKey))
+		}
+
+		source, ok := davinciFlowGraphStringAttribute(edgeData.Attributes(), "source")
+		if !ok {
+			return graph, function.NewArgumentFuncError(0, fmt.Sprintf("The edge %q of graph_data.elements.edges has no data.source value.", edgeKey))
+		}
+
+		target, ok := davinciFlowGraphStringAttribute(edgeData.Attributes(), "target")
+		if !ok {
+			return graph, function.NewArgumentFuncError(0, fmt.Sprintf("The edge %q of graph_data.elements.edges has no data.target value.", edgeKey))
+		}
+
+		// Edges to or from annotations, or to nodes that do not exist, are not part of the flow
+		if _, ok := nodeData[source]; !ok {
+			continue
+		}
+		if _, ok := nodeData[target]; !ok {
+			continue
+		}
+
+		graph.Edges = append(graph.Edges, davinciFlowGraphEdge{
+			Key:    edgeKey,
+			Source: source,
+			Target: target,
+			Label:  davinciFlowGraphEdgeLabel(nodeData[source], target),
+		})
+	}
+
+	return graph, nil
+}
+
+// davinciFlowGraphStringAttribute returns the value of a string attribute, and whether the attribute is a known, non-null string
+func davinciFlowGraphStringAttribute(attributes map[string]attr.Value, name string) (string, bool) {
+	v, ok := attributes[name].(types.String)
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+
+	return v.ValueString(), true
+}
+
+// davinciFlowGraphNodeLabel returns the node label, followed by the connector capability the node runs
+func davinciFlowGraphNodeLabel(data map[string]attr.Value) string {
+	label, _ := davinciFlowGraphStringAttribute(data, "label")
+	if label == "" {
+		label, _ = davinciFlowGraphStringAttribute(data, "node_type")
+	}
+
+	capability, _ := davinciFlowGraphStringAttribute(data, "capability_name")
+	if connectorId, _ := davinciFlowGraphStringAttribute(data, "connector_id"); connectorId != "" && capability != "" {
+		capability = fmt.Sprintf("%s.%s", connectorId, capability)
+	}
+
+	if capability == "" {
+		return label
+	}
+
+	return fmt.Sprintf("%s\n%s", label, capability)
+}
+
+// davinciFlowGraphEdgeLabel returns the outcome that leads from the source node to the target node.  Evaluator
+// nodes hold the condition for each outgoing edge in their properties, keyed by the target node, while other nodes
+// may declare outcomes with the target node as the result.
+func davinciFlowGraphEdgeLabel(sourceData map[string]attr.Value, target string) string {
+	if properties, ok := sourceData["properties"].(jsontypes.Normalized); ok && !properties.IsNull() && !properties.IsUnknown() && properties.ValueString() != "" {
+		var propertiesMap map[string]any
+		if err := json.Unmarshal([]byte(properties.ValueString()), &propertiesMap); err == nil {
+			if property, ok := propertiesMap[target].(map[string]any); ok {
+				if value, ok := property["value"].(string); ok {
+					return value
+				}
+			}
+		}
+	}
+
+	if outcomes, ok := sourceData["outcomes"].(types.List); ok {
+		for _, outcome := range outcomes.Elements() {
+			outcomeObject, ok := outcome.(types.Object)
+			if !ok || outcomeObject.IsNull() || outcomeObject.IsUnknown() {
+				continue
+			}
+
+			if result, ok := davinciFlowGraphStringAttribute(outcomeObject.Attributes(), "result"); ok && result == target {
+				label, _ := davinciFlowGraphStringAttribute(outcomeObject.Attributes(), "label")
+				return label
+			}
+		}
+	}
+
+	return ""
+}
+
+// Mermaid renders the graph as a Mermaid flowchart
+func (g davinciFlowGraph) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	for _, node := range g.Nodes {
+		label := mermaidQuote(node.Label)
+		if node.NodeType == "EVAL" {
+			fmt.Fprintf(&b, "  %s{%s}\n", mermaidId(node.Key), label)
+		} else {
+			fmt.Fprintf(&b, "  %s[%s]\n", mermaidId(node.Key), label)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		if edge.Label == "" {
+			fmt.Fprintf(&b, "  %s --> %s\n", mermaidId(edge.Source), mermaidId(edge.Target))
+		} else {
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n", mermaidId(edge.Source), mermaidQuote(edge.Label), mermaidId(edge.Target))
+		}
+	}
+
+	return b.String()
+}
+
+// Dot renders the graph as a Graphviz DOT digraph
+func (g davinciFlowGraph) Dot() string {
+	var b strings.Builder
+	b.WriteString("digraph flow {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	for _, node := range g.Nodes {
+		shape := "box"
+		if node.NodeType == "EVAL" {
+			shape = "diamond"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, shape=%s];\n", dotQuote(node.Key), dotQuote(node.Label), shape)
+	}
+
+	for _, edge := range g.Edges {
+		if edge.Label == "" {
+			fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(edge.Source), dotQuote(edge.Target))
+		} else {
+			fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(edge.Source), dotQuote(edge.Target), dotQuote(edge.Label))
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// mermaidId returns a Mermaid node ID for the node key.  Keys are prefixed so that they cannot clash with Mermaid
+// keywords such as "end", and characters that are not valid in an ID are replaced.
+func mermaidId(key string) string {
+	return "n_" + strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// mermaidQuote returns the text as a quoted Mermaid label, with line breaks and quotes escaped
+func mermaidQuote(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", "<br/>")
+	return `"` + s + `"`
+}
+
+// dotQuote returns the text as a quoted DOT ID
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
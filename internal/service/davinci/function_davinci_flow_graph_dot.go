@@ -0,0 +1,60 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Types
+type davinciFlowGraphDotFunction struct{}
+
+// Framework interfaces
+var (
+	_ function.Function = &davinciFlowGraphDotFunction{}
+)
+
+// New Object
+func NewDavinciFlowGraphDotFunction() function.Function {
+	return &davinciFlowGraphDotFunction{}
+}
+
+// Metadata
+func (f *davinciFlowGraphDotFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "davinci_flow_graph_dot"
+}
+
+// Definition
+func (f *davinciFlowGraphDotFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Renders the graph of a DaVinci flow as a Graphviz DOT digraph.",
+		Description:         "Renders the \"graph_data\" of a DaVinci flow as a Graphviz DOT digraph, that can be rendered with Graphviz tooling to visualise the flow.  Each node is shown with its label and the connector capability it runs, evaluator nodes are shown as diamonds, and edges are labelled with the outcome that leads to the target node.  Annotation nodes are omitted.",
+		MarkdownDescription: "Renders the `graph_data` of a DaVinci flow as a Graphviz DOT digraph, that can be rendered with Graphviz tooling to visualise the flow.  Each node is shown with its label and the connector capability it runs, evaluator nodes are shown as diamonds, and edges are labelled with the outcome that leads to the target node.  Annotation nodes are omitted.",
+
+		Parameters: []function.Parameter{
+			davinciFlowGraphParameter(ctx),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run
+func (f *davinciFlowGraphDotFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var graphData types.Object
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &graphData))
+	if resp.Error != nil {
+		return
+	}
+
+	graph, funcErr := newDavinciFlowGraph(graphData)
+	resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, graph.Dot()))
+}
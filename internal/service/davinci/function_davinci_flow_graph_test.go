@@ -0,0 +1,102 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+)
+
+func TestAccDavinciFlowGraphFunctions_Values(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoBeta(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciFlowGraphFunctions_ValuesHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("mermaid", `flowchart LR
+  n_2pzouq7el7["Error Message<br/>errorConnector.customErrorMessage"]
+  n_8n3jzbt4a1["Error Message<br/>errorConnector.customErrorMessage"]
+  n_2pzouq7el7 --> n_8n3jzbt4a1
+`),
+					resource.TestCheckOutput("dot", `digraph flow {
+  rankdir=LR;
+  "2pzouq7el7" [label="Error Message\nerrorConnector.customErrorMessage", shape=box];
+  "8n3jzbt4a1" [label="Error Message\nerrorConnector.customErrorMessage", shape=box];
+  "2pzouq7el7" -> "8n3jzbt4a1";
+}
+`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDavinciFlowGraphFunctions_InvalidGraph(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoBeta(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      davinciFlowGraphFunctions_InvalidGraphHCL("davinci_flow_graph_mermaid"),
+				ExpectError: regexp.MustCompile(`has no\s+data.target\s+value`),
+			},
+			{
+				Config:      davinciFlowGraphFunctions_InvalidGraphHCL("davinci_flow_graph_dot"),
+				ExpectError: regexp.MustCompile(`has no\s+data.target\s+value`),
+			},
+		},
+	})
+}
+
+func davinciFlowGraphFunctions_ValuesHCL() string {
+	return fmt.Sprintf(`
+locals {
+  flow = provider::pingone::davinci_flow_from_export(%[1]s)
+}
+
+output "mermaid" {
+  value = provider::pingone::davinci_flow_graph_mermaid(local.flow.graph_data)
+}
+
+output "dot" {
+  value = provider::pingone::davinci_flow_graph_dot(local.flow.graph_data)
+}`, davinciFlowFromExportFunction_ExportJSON("c7d9b5a5f2b34b1d8d5f4e0e2e6c6c8a"))
+}
+
+func davinciFlowGraphFunctions_InvalidGraphHCL(functionName string) string {
+	return fmt.Sprintf(`
+locals {
+  flow = provider::pingone::davinci_flow_from_export(%[2]s)
+}
+
+output "graph" {
+  value = provider::pingone::%[1]s(merge(local.flow.graph_data, {
+    elements = merge(local.flow.graph_data.elements, {
+      edges = { for k, edge in local.flow.graph_data.elements.edges : k => merge(edge, { data = merge(edge.data, { target = null }) }) }
+    })
+  }))
+}`, functionName, davinciFlowFromExportFunction_ExportJSON("c7d9b5a5f2b34b1d8d5f4e0e2e6c6c8a"))
+}
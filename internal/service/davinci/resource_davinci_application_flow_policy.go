@@ -0,0 +1,497 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pingidentity/pingone-go-client/pingone"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+)
+
+var (
+	_ resource.ResourceWithValidateConfig = &davinciApplicationFlowPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &davinciApplicationFlowPolicyResource{}
+)
+
+var davinciApplicationFlowPolicyTrafficSplitAttrTypes = map[string]attr.Type{
+	"flow_id":       types.StringType,
+	"ip_restricted": types.BoolType,
+	"percentage":    types.Float32Type,
+	"version":       types.Float32Type,
+}
+
+// The total of the flow distribution weights required by the service
+const davinciApplicationFlowPolicyTotalWeight = 100
+
+func (r *davinciApplicationFlowPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data davinciApplicationFlowPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FlowDistributions.IsNull() || data.FlowDistributions.IsUnknown() {
+		return
+	}
+
+	flowDistributions := data.FlowDistributions.Elements()
+
+	// Ensure each flow version is only distributed once
+	flowVersionElements := map[string]attr.Value{}
+	for _, flowDistribution := range flowDistributions {
+		if flowDistribution.IsUnknown() {
+			continue
+		}
+
+		flowDistributionAttrs := flowDistribution.(types.Object).Attributes()
+		flowId := flowDistributionAttrs["id"].(types.String)
+		version := flowDistributionAttrs["version"].(types.Float32)
+		if flowId.IsUnknown() || version.IsUnknown() {
+			continue
+		}
+
+		flowVersionKey := fmt.Sprintf("%s/%s", flowId.ValueString(), davinciFlowVersionString(version.ValueFloat32()))
+		if _, ok := flowVersionElements[flowVersionKey]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("flow_distributions").AtSetValue(flowDistribution),
+				"Duplicate Flow Distribution",
+				fmt.Sprintf("Version %s of flow %q is included in `flow_distributions` more than once.  Each flow version can only be distributed once.", davinciFlowVersionString(version.ValueFloat32()), flowId.ValueString()),
+			)
+			continue
+		}
+		flowVersionElements[flowVersionKey] = flowDistribution
+	}
+
+	// Ensure the weights add up to the required total
+	var totalWeight float64
+	for _, flowDistribution := range flowDistributions {
+		if flowDistribution.IsUnknown() {
+			return
+		}
+
+		weight := flowDistribution.(types.Object).Attributes()["weight"].(types.Float32)
+		if weight.IsUnknown() {
+			return
+		}
+
+		if weight.IsNull() {
+			// A single flow distribution receives all traffic without a weight
+			if len(flowDistributions) > 1 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("flow_distributions").AtSetValue(flowDistribution),
+					"Missing Flow Distribution Weight",
+					"The `weight` attribute must be set on each flow distribution when `flow_distributions` contains more than one flow distribution.",
+				)
+			}
+			continue
+		}
+
+		totalWeight += float64(weight.ValueFloat32())
+	}
+
+	if !resp.Diagnostics.HasError() && (len(flowDistributions) > 1 || totalWeight > 0) && math.Abs(totalWeight-davinciApplicationFlowPolicyTotalWeight) > 0.001 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("flow_distributions"),
+			"Invalid Flow Distribution Weights",
+			fmt.Sprintf("The `weight` values of `flow_distributions` must add up to %d, got %s.", davinciApplicationFlowPolicyTotalWeight, strconv.FormatFloat(totalWeight, 'f', -1, 32)),
+		)
+	}
+}
+
+func (r *davinciApplicationFlowPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *davinciApplicationFlowPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+
+	if r.Client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only validate the referenced flow versions when the flow distributions change, to avoid API calls on every plan
+	if state == nil || !plan.FlowDistributions.Equal(state.FlowDistributions) {
+		resp.Diagnostics.Append(r.validateFlowDistributionVersions(ctx, plan)...)
+	}
+}
+
+// validateFlowDistributionVersions validates that the flow versions referenced by the flow distributions exist, and that
+// the success nodes of each flow distribution are nodes of the flow version's graph
+func (r *davinciApplicationFlowPolicyResource) validateFlowDistributionVersions(ctx context.Context, plan *davinciApplicationFlowPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.EnvironmentId.IsUnknown() || plan.FlowDistributions.IsNull() || plan.FlowDistributions.IsUnknown() {
+		return diags
+	}
+
+	environmentIdUuid, err := uuid.Parse(plan.EnvironmentId.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	for _, flowDistribution := range plan.FlowDistributions.Elements() {
+		if flowDistribution.IsUnknown() {
+			continue
+		}
+
+		flowDistributionAttrs := flowDistribution.(types.Object).Attributes()
+		flowId := flowDistributionAttrs["id"].(types.String)
+		version := flowDistributionAttrs["version"].(types.Float32)
+
+		// Flows created in the same plan are not known yet
+		if flowId.IsUnknown() || version.IsUnknown() {
+			continue
+		}
+
+		versionId := davinciFlowVersionString(version.ValueFloat32())
+
+		var versionData *pingone.DaVinciFlowVersionDetailResponse
+		getDiags := framework.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.DaVinciFlowVersionsApi.GetDetailsByFlowIdAndVersionId(ctx, environmentIdUuid, flowId.ValueString(), versionId).Execute()
+				return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"GetDetailsByFlowIdAndVersionId",
+			framework.CustomErrorResourceNotFoundWarning,
+			framework.DefaultCreateReadRetryable,
+			&versionData,
+		)
+		if getDiags.HasError() {
+			// Failures to read the flow version must not block the plan
+			tflog.Warn(ctx, "Unable to retrieve DaVinci flow version to validate flow distribution", map[string]interface{}{
+				"environment_id": plan.EnvironmentId.ValueString(),
+				"flow_id":        flowId.ValueString(),
+				"version":        versionId,
+			})
+			continue
+		}
+
+		flowDistributionPath := path.Root("flow_distributions").AtSetValue(flowDistribution)
+
+		if versionData == nil {
+			// The version may be created in the same apply, for example when a flow is updated and deployed alongside a canary flow distribution
+			diags.AddAttributeWarning(
+				flowDistributionPath.AtName("version"),
+				"DaVinci Flow Version Not Found",
+				fmt.Sprintf("Version %s of flow %q does not exist in environment %q.  If the version is not created in this apply, for example by deploying the flow, the flow policy cannot be applied.", versionId, flowId.ValueString(), plan.EnvironmentId.ValueString()),
+			)
+			continue
+		}
+
+		successNodes, ok := flowDistributionAttrs["success_nodes"].(types.Set)
+		if !ok || successNodes.IsNull() || successNodes.IsUnknown() || versionData.GraphData == nil {
+			continue
+		}
+
+		nodeIds := map[string]bool{}
+		for _, node := range versionData.GraphData.Elements.Nodes {
+			nodeIds[node.Data.Id] = true
+		}
+
+		for _, successNode := range successNodes.Elements() {
+			if successNode.IsUnknown() {
+				continue
+			}
+
+			successNodeId := successNode.(types.Object).Attributes()["id"].(types.String)
+			if successNodeId.IsUnknown() || nodeIds[successNodeId.ValueString()] {
+				continue
+			}
+
+			diags.AddAttributeError(
+				flowDistributionPath.AtName("success_nodes").AtSetValue(successNode),
+				"DaVinci Flow Success Node Not Found",
+				fmt.Sprintf("The success node %q is not a node of version %s of flow %q.", successNodeId.ValueString(), versionId, flowId.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// davinciApplicationFlowPolicyWithTrafficSplitResource extends the generated pingone_davinci_application_flow_policy
+// resource with the computed `traffic_split` attribute, derived from `flow_distributions`.  All other requests are
+// delegated to the generated resource.
+type davinciApplicationFlowPolicyWithTrafficSplitResource struct {
+	davinciApplicationFlowPolicyResource
+}
+
+type davinciApplicationFlowPolicyWithTrafficSplitResourceModel struct {
+	davinciApplicationFlowPolicyResourceModel
+	TrafficSplit types.List `tfsdk:"traffic_split"`
+}
+
+var (
+	_ resource.Resource                   = &davinciApplicationFlowPolicyWithTrafficSplitResource{}
+	_ resource.ResourceWithConfigure      = &davinciApplicationFlowPolicyWithTrafficSplitResource{}
+	_ resource.ResourceWithImportState    = &davinciApplicationFlowPolicyWithTrafficSplitResource{}
+	_ resource.ResourceWithValidateConfig = &davinciApplicationFlowPolicyWithTrafficSplitResource{}
+	_ resource.ResourceWithModifyPlan     = &davinciApplicationFlowPolicyWithTrafficSplitResource{}
+)
+
+func NewDavinciApplicationFlowPolicyWithTrafficSplitResource() resource.Resource {
+	return &davinciApplicationFlowPolicyWithTrafficSplitResource{}
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.davinciApplicationFlowPolicyResource.Schema(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Schema.Attributes["traffic_split"] = schema.ListNestedAttribute{
+		Description:         "A summary of the effective traffic split of the policy, with an entry for each flow version in \"flow_distributions\", ordered from the largest to the smallest share of traffic.",
+		MarkdownDescription: "A summary of the effective traffic split of the policy, with an entry for each flow version in `flow_distributions`, ordered from the largest to the smallest share of traffic.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"flow_id": schema.StringAttribute{
+					Description: "The ID of the flow.",
+					Computed:    true,
+				},
+				"ip_restricted": schema.BoolAttribute{
+					Description:         "Whether the distribution only applies to requests from the IP ranges in its \"ip\" condition.",
+					MarkdownDescription: "Whether the distribution only applies to requests from the IP ranges in its `ip` condition.",
+					Computed:            true,
+				},
+				"percentage": schema.Float32Attribute{
+					Description: "The percentage of traffic sent to the flow version.",
+					Computed:    true,
+				},
+				"version": schema.Float32Attribute{
+					Description: "The version of the flow.",
+					Computed:    true,
+				},
+			},
+		},
+		Computed: true,
+	}
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciApplicationFlowPolicyResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.validateConfig(ctx, &r.davinciApplicationFlowPolicyResource, req, resp)
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciApplicationFlowPolicyResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.modifyPlan(ctx, &r.davinciApplicationFlowPolicyResource, req, resp)
+	if resp.Diagnostics.HasError() || resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The traffic split is derived from the planned flow distributions
+	var plan davinciApplicationFlowPolicyWithTrafficSplitResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trafficSplit, diags := davinciApplicationFlowPolicyTrafficSplit(plan.FlowDistributions)
+	resp.Diagnostics.Append(diags...)
+	if !trafficSplit.Equal(plan.TrafficSplit) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("traffic_split"), trafficSplit)...)
+	}
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciApplicationFlowPolicyResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.create(ctx, &r.davinciApplicationFlowPolicyResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDavinciApplicationFlowPolicyStateTrafficSplit(ctx, &resp.State)...)
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciApplicationFlowPolicyResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.read(ctx, &r.davinciApplicationFlowPolicyResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDavinciApplicationFlowPolicyStateTrafficSplit(ctx, &resp.State)...)
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciApplicationFlowPolicyResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.update(ctx, &r.davinciApplicationFlowPolicyResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDavinciApplicationFlowPolicyStateTrafficSplit(ctx, &resp.State)...)
+}
+
+func (r *davinciApplicationFlowPolicyWithTrafficSplitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciApplicationFlowPolicyResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.delete(ctx, &r.davinciApplicationFlowPolicyResource, req, resp)
+}
+
+// setDavinciApplicationFlowPolicyStateTrafficSplit sets `traffic_split` from the `flow_distributions` in state
+func setDavinciApplicationFlowPolicyStateTrafficSplit(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The resource has been removed from state
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	var data davinciApplicationFlowPolicyWithTrafficSplitResourceModel
+	diags.Append(state.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	trafficSplit, d := davinciApplicationFlowPolicyTrafficSplit(data.FlowDistributions)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("traffic_split"), trafficSplit)...)
+
+	return diags
+}
+
+// davinciApplicationFlowPolicyTrafficSplit returns the effective traffic split of the flow distributions, ordered
+// from the largest to the smallest share of traffic
+func davinciApplicationFlowPolicyTrafficSplit(flowDistributions types.Set) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	trafficSplitElementType := types.ObjectType{AttrTypes: davinciApplicationFlowPolicyTrafficSplitAttrTypes}
+
+	if flowDistributions.IsUnknown() {
+		return types.ListUnknown(trafficSplitElementType), diags
+	}
+
+	if flowDistributions.IsNull() {
+		return types.ListNull(trafficSplitElementType), diags
+	}
+
+	type trafficSplitEntry struct {
+		flowId       string
+		ipRestricted bool
+		percentage   float32
+		version      float32
+	}
+
+	elements := flowDistributions.Elements()
+	entries := make([]trafficSplitEntry, 0, len(elements))
+	for _, flowDistribution := range elements {
+		if flowDistribution.IsUnknown() {
+			return types.ListUnknown(trafficSplitElementType), diags
+		}
+
+		flowDistributionAttrs := flowDistribution.(types.Object).Attributes()
+		flowId := flowDistributionAttrs["id"].(types.String)
+		ip := flowDistributionAttrs["ip"].(types.Set)
+		version := flowDistributionAttrs["version"].(types.Float32)
+		weight := flowDistributionAttrs["weight"].(types.Float32)
+		if flowId.IsUnknown() || ip.IsUnknown() || version.IsUnknown() || weight.IsUnknown() {
+			return types.ListUnknown(trafficSplitElementType), diags
+		}
+
+		entry := trafficSplitEntry{
+			flowId:       flowId.ValueString(),
+			ipRestricted: len(ip.Elements()) > 0,
+			percentage:   weight.ValueFloat32(),
+			version:      version.ValueFloat32(),
+		}
+
+		// A single flow distribution receives all traffic without a weight
+		if weight.IsNull() && len(elements) == 1 {
+			entry.percentage = davinciApplicationFlowPolicyTotalWeight
+		}
+
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b trafficSplitEntry) int {
+		return cmp.Or(
+			cmp.Compare(b.percentage, a.percentage),
+			cmp.Compare(a.flowId, b.flowId),
+			cmp.Compare(a.version, b.version),
+		)
+	})
+
+	trafficSplitValues := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		trafficSplitValue, d := types.ObjectValue(davinciApplicationFlowPolicyTrafficSplitAttrTypes, map[string]attr.Value{
+			"flow_id":       types.StringValue(entry.flowId),
+			"ip_restricted": types.BoolValue(entry.ipRestricted),
+			"percentage":    types.Float32Value(entry.percentage),
+			"version":       types.Float32Value(entry.version),
+		})
+		diags.Append(d...)
+		trafficSplitValues = append(trafficSplitValues, trafficSplitValue)
+	}
+
+	trafficSplit, d := types.ListValue(trafficSplitElementType, trafficSplitValues)
+	diags.Append(d...)
+
+	return trafficSplit, diags
+}
+
+// davinciFlowVersionString returns the flow version as used in the flow version API paths
+func davinciFlowVersionString(version float32) string {
+	return strconv.FormatFloat(float64(version), 'f', -1, 32)
+}
@@ -0,0 +1,138 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+)
+
+func TestAccDavinciApplicationFlowPolicy_InvalidFlowDistributions(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciApplicationFlowPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Weights do not add up to 100
+			{
+				Config: davinciApplicationFlowPolicy_FlowDistributionsHCL(resourceName, `
+    {
+      id      = "0123456789abcdef0123456789abcdef"
+      version = 0
+      weight  = 40
+    },
+    {
+      id      = "0123456789abcdef0123456789abcdef"
+      version = 1
+      weight  = 40
+    }`),
+				ExpectError: regexp.MustCompile("The `weight` values of `flow_distributions` must add up to 100, got 80."),
+			},
+			// Weight missing with more than one flow distribution
+			{
+				Config: davinciApplicationFlowPolicy_FlowDistributionsHCL(resourceName, `
+    {
+      id      = "0123456789abcdef0123456789abcdef"
+      version = 0
+      weight  = 100
+    },
+    {
+      id      = "0123456789abcdef0123456789abcdef"
+      version = 1
+    }`),
+				ExpectError: regexp.MustCompile("The `weight` attribute must be set on each flow distribution"),
+			},
+			// Duplicate flow version
+			{
+				Config: davinciApplicationFlowPolicy_FlowDistributionsHCL(resourceName, `
+    {
+      id      = "0123456789abcdef0123456789abcdef"
+      version = 0
+      weight  = 50
+    },
+    {
+      id      = "0123456789abcdef0123456789abcdef"
+      version = 0
+      weight  = 50
+      ip      = ["0.0.0.0/0"]
+    }`),
+				ExpectError: regexp.MustCompile(`Version 0 of flow "0123456789abcdef0123456789abcdef" is included in\s+` + "`flow_distributions`" + ` more than once.`),
+			},
+		},
+	})
+}
+
+func TestAccDavinciApplicationFlowPolicy_TrafficSplit(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_application_flow_policy.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciApplicationFlowPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciApplicationFlowPolicy_CompleteHCL(resourceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "traffic_split.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceFullName, "traffic_split.0.flow_id", fmt.Sprintf("pingone_davinci_flow.%s-second", resourceName), "id"),
+					resource.TestCheckResourceAttr(resourceFullName, "traffic_split.0.percentage", "55"),
+					resource.TestCheckResourceAttr(resourceFullName, "traffic_split.0.version", "0"),
+					resource.TestCheckResourceAttr(resourceFullName, "traffic_split.0.ip_restricted", "true"),
+					resource.TestCheckResourceAttrPair(resourceFullName, "traffic_split.1.flow_id", fmt.Sprintf("pingone_davinci_flow.%s-first", resourceName), "id"),
+					resource.TestCheckResourceAttr(resourceFullName, "traffic_split.1.percentage", "45"),
+				),
+			},
+			// A flow version that does not exist yet does not block the plan, as it may be created in the same apply
+			{
+				Config:             strings.Replace(davinciApplicationFlowPolicy_CompleteHCL(resourceName, false), "version = 0\n      weight  = 45", "version = 99\n      weight  = 45", 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Success nodes must be nodes of the flow version's graph
+			{
+				Config:      strings.Replace(davinciApplicationFlowPolicy_CompleteHCL(resourceName, false), `id = "nodefirstflow"`, `id = "nodemissing"`, 1),
+				ExpectError: regexp.MustCompile(`The success node "nodemissing" is not a node of version 0 of flow`),
+			},
+		},
+	})
+}
+
+func davinciApplicationFlowPolicy_FlowDistributionsHCL(resourceName, flowDistributions string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_application" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+}
+
+resource "pingone_davinci_application_flow_policy" "%[2]s" {
+  environment_id         = data.pingone_environment.general_test.id
+  davinci_application_id = pingone_davinci_application.%[2]s.id
+  flow_distributions = [%[3]s
+  ]
+}
+`, acctest.GenericSandboxEnvironment(), resourceName, flowDistributions)
+}
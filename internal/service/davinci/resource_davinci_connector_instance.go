@@ -0,0 +1,286 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pingidentity/pingone-go-client/pingone"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+)
+
+var (
+	_ resource.ResourceWithModifyPlan = &davinciConnectorInstanceResource{}
+)
+
+var (
+	// connectorDetailsCache holds the connector definitions used to validate connector instance properties, keyed by
+	// environment ID and connector ID, so that each definition is read once when planning many connector instances
+	connectorDetailsCache sync.Map
+
+	// davinciVariableExpressionRegexp matches a DaVinci variable expression, such as {{global.variables.timeout}},
+	// which is resolved by the service when the connector runs
+	davinciVariableExpressionRegexp = regexp.MustCompile(`\{\{[^{}]+\}\}`)
+)
+
+func (r *davinciConnectorInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *davinciConnectorInstanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan == nil || r.Client == nil {
+		return
+	}
+
+	// Validate the properties against the connector definition when they are created or changed
+	if state == nil || !state.Properties.Equal(plan.Properties) || !state.Connector.Equal(plan.Connector) {
+		resp.Diagnostics.Append(r.validateProperties(ctx, plan)...)
+	}
+}
+
+// validateProperties checks the configured properties against the property definitions of the connector, so that
+// missing required properties, values of the wrong type and values that are not one of the allowed options are
+// reported at plan time.  The property values are sensitive, so they are not included in any diagnostic.
+//
+// The property definitions are read from the environment rather than from a bundle generated into the provider by
+// dvgenerate.  Connectors are versioned by the service independently of provider releases, and the connectors
+// available differ between environments, so a generated bundle would report errors against properties that the
+// service accepts whenever the two drift.  Definitions are only read when the properties or connector change, and
+// are cached for the life of the provider process.
+func (r *davinciConnectorInstanceResource) validateProperties(ctx context.Context, plan *davinciConnectorInstanceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.Properties.IsNull() || plan.Properties.IsUnknown() || plan.EnvironmentId.IsUnknown() || plan.Connector.IsNull() || plan.Connector.IsUnknown() {
+		return diags
+	}
+
+	connectorId, ok := plan.Connector.Attributes()["id"].(types.String)
+	if !ok || connectorId.IsNull() || connectorId.IsUnknown() {
+		return diags
+	}
+
+	var properties map[string]any
+	if err := json.Unmarshal([]byte(plan.Properties.ValueString()), &properties); err != nil {
+		// Invalid JSON is reported by the attribute's type
+		return diags
+	}
+
+	environmentIdUuid, err := uuid.Parse(plan.EnvironmentId.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	connectorDetailsCacheKey := fmt.Sprintf("%s/%s", environmentIdUuid.String(), connectorId.ValueString())
+
+	var connectorDetails *pingone.DaVinciConnectorDetailsResponse
+	if v, ok := connectorDetailsCache.Load(connectorDetailsCacheKey); ok {
+		connectorDetails = v.(*pingone.DaVinciConnectorDetailsResponse)
+	} else {
+		detailsDiags := framework.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.DaVinciConnectorsApi.GetDetailsByConnectorId(ctx, environmentIdUuid, connectorId.ValueString()).Execute()
+				return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"GetDetailsByConnectorId",
+			framework.DefaultCustomError,
+			framework.DefaultCreateReadRetryable,
+			&connectorDetails,
+		)
+		if detailsDiags.HasError() || connectorDetails == nil {
+			// The check is advisory, so a failure to retrieve the connector definition must not block the plan
+			tflog.Warn(ctx, "Unable to retrieve the DaVinci connector definition to validate connector instance properties", map[string]interface{}{
+				"environment_id": plan.EnvironmentId.ValueString(),
+				"connector_id":   connectorId.ValueString(),
+			})
+			diags.AddAttributeWarning(
+				path.Root("properties"),
+				"Connector instance properties not validated",
+				fmt.Sprintf("The definition of the %q connector could not be retrieved from the environment, so the connector instance properties have not been validated at plan time.  Invalid properties will be reported by the service when the plan is applied.", connectorId.ValueString()),
+			)
+			return diags
+		}
+
+		connectorDetailsCache.Store(connectorDetailsCacheKey, connectorDetails)
+	}
+
+	return validateConnectorInstanceProperties(connectorId.ValueString(), properties, connectorDetails)
+}
+
+// validateConnectorInstanceProperties validates the properties JSON object of a connector instance against the
+// connector details
+func validateConnectorInstanceProperties(connectorId string, properties map[string]any, connectorDetails *pingone.DaVinciConnectorDetailsResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The properties shown when configuring the connection, as opposed to the properties of the connector's capabilities
+	connectionPropertyNames := map[string]bool{}
+	var viewItems []map[string]interface{}
+	if connectorDetails.AccountConfigView != nil {
+		viewItems = append(viewItems, connectorDetails.AccountConfigView.Items...)
+	}
+	if connectorDetails.CredentialsView != nil {
+		viewItems = append(viewItems, connectorDetails.CredentialsView.Items...)
+	}
+	for _, item := range viewItems {
+		if propertyName, ok := item["propertyName"].(string); ok && propertyName != "" {
+			connectionPropertyNames[propertyName] = true
+		}
+	}
+
+	for _, propertyName := range slices.Sorted(maps.Keys(properties)) {
+		definition, ok := connectorDetails.Properties[propertyName].(map[string]any)
+		if !ok {
+			if len(connectionPropertyNames) > 0 {
+				diags.AddAttributeWarning(
+					path.Root("properties"),
+					"Unknown connector instance property",
+					fmt.Sprintf("The property %q is not defined by the %q connector, and may be ignored by the service.", propertyName, connectorId),
+				)
+			}
+			continue
+		}
+
+		property, ok := properties[propertyName].(map[string]any)
+		if !ok {
+			continue
+		}
+		value, ok := property["value"]
+		if !ok || value == nil {
+			continue
+		}
+
+		propertyType, _ := definition["type"].(string)
+		if !connectorPropertyValueMatchesType(propertyType, value) {
+			diags.AddAttributeError(
+				path.Root("properties"),
+				"Invalid connector instance property",
+				fmt.Sprintf("The value of property %q must be of type %s for the %q connector.", propertyName, propertyType, connectorId),
+			)
+			continue
+		}
+
+		// Variable expressions are resolved by the service when the connector runs, so cannot be checked here
+		if isDavinciVariableExpression(value) {
+			continue
+		}
+
+		if allowedValues := connectorPropertyAllowedValues(definition); len(allowedValues) > 0 {
+			if !slices.Contains(allowedValues, fmt.Sprint(value)) {
+				diags.AddAttributeError(
+					path.Root("properties"),
+					"Invalid connector instance property",
+					fmt.Sprintf("The value of property %q is not an allowed value for the %q connector.  Allowed values are: %s.", propertyName, connectorId, strings.Join(allowedValues, ", ")),
+				)
+			}
+		}
+	}
+
+	// Required connection properties that have no default value in the connector definition must be configured
+	for _, propertyName := range slices.Sorted(maps.Keys(connectionPropertyNames)) {
+		definition, ok := connectorDetails.Properties[propertyName].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if required, ok := definition["required"].(bool); !ok || !required {
+			continue
+		}
+
+		if defaultValue, ok := definition["value"]; ok && defaultValue != nil && defaultValue != "" {
+			continue
+		}
+
+		if property, ok := properties[propertyName].(map[string]any); ok {
+			if value, ok := property["value"]; ok && value != nil && value != "" {
+				continue
+			}
+		}
+
+		diags.AddAttributeError(
+			path.Root("properties"),
+			"Missing required connector instance property",
+			fmt.Sprintf("The property %q is required by the %q connector, but has not been configured with a value.", propertyName, connectorId),
+		)
+	}
+
+	return diags
+}
+
+// connectorPropertyValueMatchesType returns whether the value is valid for the connector property type.  Boolean and
+// number values may also be given in their string form, or as a DaVinci variable expression.  String properties are
+// not validated, as some connectors declare properties that hold structured values with the string type.
+func connectorPropertyValueMatchesType(propertyType string, value any) bool {
+	if isDavinciVariableExpression(value) {
+		return true
+	}
+
+	switch propertyType {
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(v)
+			return err == nil
+		}
+		return false
+	case "number":
+		switch v := value.(type) {
+		case float64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(v, 64)
+			return err == nil
+		}
+		return false
+	}
+
+	return true
+}
+
+// isDavinciVariableExpression returns whether the value is a string that contains a DaVinci variable expression
+func isDavinciVariableExpression(value any) bool {
+	v, ok := value.(string)
+	return ok && davinciVariableExpressionRegexp.MatchString(v)
+}
+
+// connectorPropertyAllowedValues returns the values of the options defined for a property, if the options are a
+// fixed list of simple values
+func connectorPropertyAllowedValues(definition map[string]any) []string {
+	options, ok := definition["options"].([]any)
+	if !ok {
+		return nil
+	}
+
+	allowedValues := make([]string, 0, len(options))
+	for _, option := range options {
+		optionMap, ok := option.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		switch v := optionMap["value"].(type) {
+		case string, bool, float64:
+			allowedValues = append(allowedValues, fmt.Sprint(v))
+		default:
+			return nil
+		}
+	}
+
+	return allowedValues
+}
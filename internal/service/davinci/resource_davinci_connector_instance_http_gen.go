@@ -0,0 +1,39 @@
+// Copyright © 2026 Ping Identity Corporation
+// Code generated by dvgenerate
+
+package davinci
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var davinciConnectorInstanceHttpConnector = davinciTypedConnector{
+	TypeName:      "davinci_connector_instance_http",
+	ConnectorId:   "httpConnector",
+	ConnectorName: "HTTP",
+	Properties: []davinciTypedConnectorProperty{
+		{
+			Attribute:   "connection_id",
+			Property:    "connectionId",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "Select an OpenID token management connection for signed HTTP responses.",
+		},
+		{
+			Attribute:   "recaptcha_secret_key",
+			Property:    "recaptchaSecretKey",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The Secret Key from reCAPTCHA Admin dashboard.",
+			Sensitive:   true,
+		},
+		{
+			Attribute:   "recaptcha_site_key",
+			Property:    "recaptchaSiteKey",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The Site Key from reCAPTCHA Admin dashboard.",
+		},
+	},
+}
+
+func NewDavinciConnectorInstanceHttpResource() resource.Resource {
+	return newDavinciTypedConnectorInstanceResource(davinciConnectorInstanceHttpConnector)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 Ping Identity Corporation
+// Code generated by dvgenerate
+
+package davinci
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var davinciConnectorInstancePingoneMfaConnector = davinciTypedConnector{
+	TypeName:      "davinci_connector_instance_pingone_mfa",
+	ConnectorId:   "pingOneMfaConnector",
+	ConnectorName: "PingOne MFA",
+	Properties: []davinciTypedConnectorProperty{
+		{
+			Attribute:   "client_id",
+			Property:    "clientId",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The Client ID of your PingOne Worker application.",
+			Required:    true,
+		},
+		{
+			Attribute:   "client_secret",
+			Property:    "clientSecret",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The Client Secret of your PingOne Worker application.",
+			Required:    true,
+			Sensitive:   true,
+		},
+		{
+			Attribute:   "env_id",
+			Property:    "envId",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "Your PingOne environment ID.",
+			Required:    true,
+		},
+		{
+			Attribute:   "policy_id",
+			Property:    "policyId",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The ID of your PingOne MFA device authentication policy.",
+		},
+		{
+			Attribute:   "region",
+			Property:    "region",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The region in which your PingOne environment exists.",
+			Required:    true,
+		},
+	},
+}
+
+func NewDavinciConnectorInstancePingoneMfaResource() resource.Resource {
+	return newDavinciTypedConnectorInstanceResource(davinciConnectorInstancePingoneMfaConnector)
+}
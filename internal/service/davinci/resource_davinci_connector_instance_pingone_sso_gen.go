@@ -0,0 +1,49 @@
+// Copyright © 2026 Ping Identity Corporation
+// Code generated by dvgenerate
+
+package davinci
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var davinciConnectorInstancePingoneSsoConnector = davinciTypedConnector{
+	TypeName:      "davinci_connector_instance_pingone_sso",
+	ConnectorId:   "pingOneSSOConnector",
+	ConnectorName: "PingOne",
+	Properties: []davinciTypedConnectorProperty{
+		{
+			Attribute:   "client_id",
+			Property:    "clientId",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The Client ID of your PingOne Worker application.",
+			Required:    true,
+		},
+		{
+			Attribute:   "client_secret",
+			Property:    "clientSecret",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The Client Secret of your PingOne Worker application.",
+			Required:    true,
+			Sensitive:   true,
+		},
+		{
+			Attribute:   "env_id",
+			Property:    "envId",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "Your PingOne environment ID.",
+			Required:    true,
+		},
+		{
+			Attribute:   "region",
+			Property:    "region",
+			Type:        davinciTypedConnectorPropertyTypeString,
+			Description: "The region in which your PingOne environment exists.",
+			Required:    true,
+		},
+	},
+}
+
+func NewDavinciConnectorInstancePingoneSsoResource() resource.Resource {
+	return newDavinciTypedConnectorInstanceResource(davinciConnectorInstancePingoneSsoConnector)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+)
+
+func TestAccDavinciConnectorInstance_InvalidProperties(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_connector_instance.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciConnectorInstance_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      davinciConnectorInstance_PropertyPortHCL(resourceName, `"not a number"`),
+				ExpectError: regexp.MustCompile(`The value of property "port" must be of type number for the "smtpConnector"\s+connector`),
+			},
+			{
+				Config:      davinciConnectorInstance_PropertyPortHCL(resourceName, `{ "number" : 2525 }`),
+				ExpectError: regexp.MustCompile(`The value of property "port" must be of type number for the "smtpConnector"\s+connector`),
+			},
+			{
+				Config:             davinciConnectorInstance_PropertyPortHCL(resourceName, `"{{global.variables.smtpPort}}"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: davinciConnectorInstance_PropertyPortHCL(resourceName, `2525`),
+				Check:  resource.TestCheckResourceAttr(resourceFullName, "connector.id", "smtpConnector"),
+			},
+		},
+	})
+}
+
+func davinciConnectorInstance_PropertyPortHCL(resourceName, port string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_connector_instance" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  connector = {
+    id = "smtpConnector"
+  }
+  name = "%[2]s"
+
+  properties = jsonencode({
+    "name" : {
+      "type" : "string",
+      "value" : "test"
+    },
+    "hostname" : {
+      "type" : "string",
+      "value" : "localhost"
+    },
+    "port" : {
+      "type" : "number",
+      "value" : %[3]s
+    },
+    "secureFlag" : {
+      "type" : "boolean",
+      "value" : true
+    },
+    "username" : {
+      "type" : "string",
+      "value" : "test"
+    },
+    "password" : {
+      "type" : "string",
+      "value" : "test"
+    }
+  })
+}
+`, acctest.DaVinciSandboxEnvironment(false), resourceName, port)
+}
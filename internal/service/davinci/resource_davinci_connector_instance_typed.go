@@ -0,0 +1,683 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/pingone-go-client/pingone"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// The typed connector instance resources are generated by tools/dvgenerate.  Each generated resource defines the
+// connector and its connection properties, and the schema and CRUD logic shared by all of them is implemented here.
+
+type davinciTypedConnectorPropertyType int
+
+const (
+	davinciTypedConnectorPropertyTypeString davinciTypedConnectorPropertyType = iota
+	davinciTypedConnectorPropertyTypeBool
+	davinciTypedConnectorPropertyTypeNumber
+	davinciTypedConnectorPropertyTypeJSON
+)
+
+// String returns the DaVinci property type, or an empty string for JSON properties, whose DaVinci type varies
+func (t davinciTypedConnectorPropertyType) String() string {
+	switch t {
+	case davinciTypedConnectorPropertyTypeString:
+		return "string"
+	case davinciTypedConnectorPropertyTypeBool:
+		return "boolean"
+	case davinciTypedConnectorPropertyTypeNumber:
+		return "number"
+	}
+
+	return ""
+}
+
+// davinciTypedConnectorProperty maps a connector property onto a resource attribute
+type davinciTypedConnectorProperty struct {
+	Attribute     string
+	Property      string
+	Type          davinciTypedConnectorPropertyType
+	Description   string
+	Required      bool
+	Sensitive     bool
+	AllowedValues []string
+}
+
+// davinciTypedConnector defines a typed connector instance resource
+type davinciTypedConnector struct {
+	TypeName      string
+	ConnectorId   string
+	ConnectorName string
+	Properties    []davinciTypedConnectorProperty
+}
+
+type davinciTypedConnectorInstanceResource struct {
+	Client    *pingone.APIClient
+	connector davinciTypedConnector
+}
+
+type davinciTypedConnectorInstanceResourceModel struct {
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+}
+
+// attributeGetter is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+var (
+	_ resource.Resource                = &davinciTypedConnectorInstanceResource{}
+	_ resource.ResourceWithConfigure   = &davinciTypedConnectorInstanceResource{}
+	_ resource.ResourceWithImportState = &davinciTypedConnectorInstanceResource{}
+)
+
+func newDavinciTypedConnectorInstanceResource(connector davinciTypedConnector) resource.Resource {
+	return &davinciTypedConnectorInstanceResource{
+		connector: connector,
+	}
+}
+
+func (r *davinciTypedConnectorInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.connector.TypeName
+}
+
+func (r *davinciTypedConnectorInstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(framework.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *davinciTypedConnectorInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"environment_id": schema.StringAttribute{
+			Required:    true,
+			Description: fmt.Sprintf("The ID of the environment to create and manage the %s in.", r.connector.TypeName),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"), "Must be a valid UUID"),
+			},
+		},
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of this resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseNonNullStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the connector instance.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtMost(256),
+			},
+		},
+	}
+
+	for _, property := range r.connector.Properties {
+		attributes[property.Attribute] = property.schemaAttribute()
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource to create and manage a DaVinci connector instance of the %s connector (`%s`), with the connection properties of the connector as typed attributes.", r.connector.ConnectorName, r.connector.ConnectorId),
+		Attributes:  attributes,
+	}
+}
+
+// schemaAttribute returns the resource attribute for the property.  Optional properties are also computed, as the
+// service may return a default value for a property that is not configured.
+func (p davinciTypedConnectorProperty) schemaAttribute() schema.Attribute {
+	description := fmt.Sprintf("%s  Sets the `%s` property of the connector instance.", p.Description, p.Property)
+	if p.Description == "" {
+		description = fmt.Sprintf("Sets the `%s` property of the connector instance.", p.Property)
+	}
+
+	switch p.Type {
+	case davinciTypedConnectorPropertyTypeBool:
+		attribute := schema.BoolAttribute{
+			Required:            p.Required,
+			Optional:            !p.Required,
+			Computed:            !p.Required,
+			Sensitive:           p.Sensitive,
+			MarkdownDescription: description,
+		}
+		if !p.Required {
+			attribute.PlanModifiers = []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
+		}
+		return attribute
+
+	case davinciTypedConnectorPropertyTypeNumber:
+		attribute := schema.Float64Attribute{
+			Required:            p.Required,
+			Optional:            !p.Required,
+			Computed:            !p.Required,
+			Sensitive:           p.Sensitive,
+			MarkdownDescription: description,
+		}
+		if !p.Required {
+			attribute.PlanModifiers = []planmodifier.Float64{float64planmodifier.UseStateForUnknown()}
+		}
+		return attribute
+
+	case davinciTypedConnectorPropertyTypeJSON:
+		attribute := schema.StringAttribute{
+			CustomType:          jsontypes.NormalizedType{},
+			Required:            p.Required,
+			Optional:            !p.Required,
+			Computed:            !p.Required,
+			Sensitive:           p.Sensitive,
+			MarkdownDescription: description,
+		}
+		if !p.Required {
+			attribute.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		}
+		return attribute
+	}
+
+	attribute := schema.StringAttribute{
+		Required:            p.Required,
+		Optional:            !p.Required,
+		Computed:            !p.Required,
+		Sensitive:           p.Sensitive,
+		MarkdownDescription: description,
+	}
+	if !p.Required {
+		attribute.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+	}
+	if len(p.AllowedValues) > 0 {
+		attribute.Validators = []validator.String{stringvalidator.OneOf(p.AllowedValues...)}
+	}
+	return attribute
+}
+
+// buildProperties builds the connector instance properties from the typed attributes
+func (r *davinciTypedConnectorInstanceResource) buildProperties(ctx context.Context, data attributeGetter) (map[string]interface{}, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	properties := map[string]interface{}{}
+
+	for _, property := range r.connector.Properties {
+		value, diags := property.getValue(ctx, data)
+		respDiags.Append(diags...)
+		if value == nil {
+			continue
+		}
+
+		propertyObject := map[string]interface{}{
+			"value": value,
+		}
+		if propertyType := property.Type.String(); propertyType != "" {
+			propertyObject["type"] = propertyType
+		}
+		properties[property.Property] = propertyObject
+	}
+
+	return properties, respDiags
+}
+
+// getValue returns the configured value of the property, or nil if it is null or unknown
+func (p davinciTypedConnectorProperty) getValue(ctx context.Context, data attributeGetter) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attributePath := path.Root(p.Attribute)
+
+	switch p.Type {
+	case davinciTypedConnectorPropertyTypeBool:
+		var v types.Bool
+		diags.Append(data.GetAttribute(ctx, attributePath, &v)...)
+		if v.IsNull() || v.IsUnknown() {
+			return nil, diags
+		}
+		return v.ValueBool(), diags
+
+	case davinciTypedConnectorPropertyTypeNumber:
+		var v types.Float64
+		diags.Append(data.GetAttribute(ctx, attributePath, &v)...)
+		if v.IsNull() || v.IsUnknown() {
+			return nil, diags
+		}
+		return v.ValueFloat64(), diags
+
+	case davinciTypedConnectorPropertyTypeJSON:
+		var v jsontypes.Normalized
+		diags.Append(data.GetAttribute(ctx, attributePath, &v)...)
+		if v.IsNull() || v.IsUnknown() {
+			return nil, diags
+		}
+		var jsonValue interface{}
+		if err := json.Unmarshal([]byte(v.ValueString()), &jsonValue); err != nil {
+			diags.AddAttributeError(
+				attributePath,
+				"Error Parsing Property",
+				fmt.Sprintf("The value provided for %s could not be parsed as json: %s", p.Attribute, err.Error()),
+			)
+			return nil, diags
+		}
+		return jsonValue, diags
+	}
+
+	var v types.String
+	diags.Append(data.GetAttribute(ctx, attributePath, &v)...)
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+	return v.ValueString(), diags
+}
+
+// stateValue returns the attribute value for a property value returned by the service.  Sensitive values are
+// returned obfuscated, in which case the prior value is retained.
+func (p davinciTypedConnectorProperty) stateValue(ctx context.Context, responseProperty interface{}, prior attributeGetter) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var value interface{}
+	if propertyMap, ok := responseProperty.(map[string]interface{}); ok {
+		value = propertyMap["value"]
+	}
+
+	if s, ok := value.(string); ok && s != "" && strings.Trim(s, "*") == "" {
+		return p.priorValue(ctx, prior)
+	}
+
+	switch p.Type {
+	case davinciTypedConnectorPropertyTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return types.BoolValue(v), diags
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return types.BoolValue(b), diags
+			}
+		}
+		return types.BoolNull(), diags
+
+	case davinciTypedConnectorPropertyTypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return types.Float64Value(v), diags
+		case string:
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return types.Float64Value(f), diags
+			}
+		}
+		return types.Float64Null(), diags
+
+	case davinciTypedConnectorPropertyTypeJSON:
+		if value == nil {
+			return jsontypes.NewNormalizedNull(), diags
+		}
+		jsonBytes, err := json.Marshal(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(p.Attribute),
+				"Error Marshaling Property",
+				fmt.Sprintf("An error occurred while marshaling the %s property: %s", p.Property, err.Error()),
+			)
+			return jsontypes.NewNormalizedNull(), diags
+		}
+		return jsontypes.NewNormalizedValue(string(jsonBytes)), diags
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), diags
+	case string:
+		return types.StringValue(v), diags
+	default:
+		return types.StringValue(fmt.Sprint(v)), diags
+	}
+}
+
+// priorValue returns the value of the property's attribute in the prior state or plan
+func (p davinciTypedConnectorProperty) priorValue(ctx context.Context, prior attributeGetter) (attr.Value, diag.Diagnostics) {
+	switch p.Type {
+	case davinciTypedConnectorPropertyTypeBool:
+		var v types.Bool
+		diags := prior.GetAttribute(ctx, path.Root(p.Attribute), &v)
+		return v, diags
+	case davinciTypedConnectorPropertyTypeNumber:
+		var v types.Float64
+		diags := prior.GetAttribute(ctx, path.Root(p.Attribute), &v)
+		return v, diags
+	case davinciTypedConnectorPropertyTypeJSON:
+		var v jsontypes.Normalized
+		diags := prior.GetAttribute(ctx, path.Root(p.Attribute), &v)
+		return v, diags
+	}
+
+	var v types.String
+	diags := prior.GetAttribute(ctx, path.Root(p.Attribute), &v)
+	return v, diags
+}
+
+// setState writes the response into the state, using the prior plan or state for values the service obfuscates
+func (r *davinciTypedConnectorInstanceResource) setState(ctx context.Context, state *tfsdk.State, data davinciTypedConnectorInstanceResourceModel, prior attributeGetter, response *pingone.DaVinciConnectorInstanceResponse) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	if response.Connector.Id != r.connector.ConnectorId {
+		respDiags.AddError(
+			"Unexpected connector",
+			fmt.Sprintf("The connector instance %q is an instance of the %q connector, but the %s resource manages instances of the %q connector.  Use the pingone_davinci_connector_instance resource to manage instances of other connectors.", response.Id, response.Connector.Id, r.connector.TypeName, r.connector.ConnectorId),
+		)
+		return respDiags
+	}
+
+	data.Id = types.StringValue(response.Id)
+	data.Name = types.StringValue(response.Name)
+	respDiags.Append(state.SetAttribute(ctx, path.Root("environment_id"), data.EnvironmentId)...)
+	respDiags.Append(state.SetAttribute(ctx, path.Root("id"), data.Id)...)
+	respDiags.Append(state.SetAttribute(ctx, path.Root("name"), data.Name)...)
+
+	for _, property := range r.connector.Properties {
+		value, diags := property.stateValue(ctx, response.Properties[property.Property], prior)
+		respDiags.Append(diags...)
+		respDiags.Append(state.SetAttribute(ctx, path.Root(property.Attribute), value)...)
+	}
+
+	return respDiags
+}
+
+func (r *davinciTypedConnectorInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data davinciTypedConnectorInstanceResourceModel
+
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment_id"), &data.EnvironmentId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &data.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	properties, diags := r.buildProperties(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientData := pingone.DaVinciConnectorInstanceCreateRequest{
+		Connector: pingone.ResourceRelationshipDaVinci{
+			Id: r.connector.ConnectorId,
+		},
+		Name:       data.Name.ValueString(),
+		Properties: properties,
+	}
+
+	environmentIdUuid, err := uuid.Parse(data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.EnvironmentId.ValueString(), "EnvironmentId", err.Error()),
+		)
+		return
+	}
+	var responseData *pingone.DaVinciConnectorInstanceResponse
+	resp.Diagnostics.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciConnectorsApi.CreateConnectorInstance(ctx, environmentIdUuid).DaVinciConnectorInstanceCreateRequest(clientData).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"CreateConnectorInstance",
+		framework.DefaultCustomError,
+		framework.DefaultCreateReadRetryable,
+		&responseData,
+	)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, data, req.Plan, responseData)...)
+}
+
+func (r *davinciTypedConnectorInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data davinciTypedConnectorInstanceResourceModel
+
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &data.EnvironmentId)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.Id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	environmentIdUuid, err := uuid.Parse(data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.EnvironmentId.ValueString(), "EnvironmentId", err.Error()),
+		)
+		return
+	}
+	var responseData *pingone.DaVinciConnectorInstanceResponse
+	resp.Diagnostics.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciConnectorsApi.GetConnectorInstanceById(ctx, environmentIdUuid, data.Id.ValueString()).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"GetConnectorInstanceById",
+		framework.CustomErrorResourceNotFoundWarning,
+		framework.DefaultCreateReadRetryable,
+		&responseData,
+	)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if responseData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, data, req.State, responseData)...)
+}
+
+func (r *davinciTypedConnectorInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data davinciTypedConnectorInstanceResourceModel
+
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment_id"), &data.EnvironmentId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &data.Id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &data.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	properties, diags := r.buildProperties(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientData := pingone.DaVinciConnectorInstanceReplaceRequest{
+		Connector: pingone.ResourceRelationshipDaVinci{
+			Id: r.connector.ConnectorId,
+		},
+		Name:       data.Name.ValueString(),
+		Properties: properties,
+	}
+
+	environmentIdUuid, err := uuid.Parse(data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.EnvironmentId.ValueString(), "EnvironmentId", err.Error()),
+		)
+		return
+	}
+	var responseData *pingone.DaVinciConnectorInstanceResponse
+	resp.Diagnostics.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciConnectorsApi.ReplaceConnectorInstanceById(ctx, environmentIdUuid, data.Id.ValueString()).DaVinciConnectorInstanceReplaceRequest(clientData).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReplaceConnectorInstanceById",
+		framework.DefaultCustomError,
+		nil,
+		&responseData,
+	)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, data, req.Plan, responseData)...)
+}
+
+func (r *davinciTypedConnectorInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data davinciTypedConnectorInstanceResourceModel
+
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_id"), &data.EnvironmentId)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.Id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	environmentIdUuid, err := uuid.Parse(data.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment_id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.EnvironmentId.ValueString(), "EnvironmentId", err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := r.Client.DaVinciConnectorsApi.DeleteConnectorInstanceById(ctx, environmentIdUuid, data.Id.ValueString()).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), nil, fR, fErr)
+		},
+		"DeleteConnectorInstanceById",
+		framework.CustomErrorResourceNotFoundWarning,
+		framework.DefaultCreateReadRetryable,
+		nil,
+	)...)
+}
+
+func (r *davinciTypedConnectorInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "instance_id",
+			Regexp:    verify.P1DVResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathKey := idComponent.Label
+
+		if idComponent.PrimaryID {
+			pathKey = "id"
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathKey), attributes[idComponent.Label])...)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccDavinciConnectorInstanceHttp_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_connector_instance_http.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciTypedConnectorInstance_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciConnectorInstanceHttp_MinimalHCL(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1DVResourceIDRegexp),
+					resource.TestCheckResourceAttr(resourceFullName, "name", resourceName),
+				),
+			},
+			{
+				Config: davinciConnectorInstanceHttp_CompleteHCL(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "recaptcha_site_key", "test-site-key"),
+					resource.TestCheckResourceAttr(resourceFullName, "recaptcha_secret_key", "test-secret-key"),
+				),
+			},
+			{
+				Config:       davinciConnectorInstanceHttp_CompleteHCL(resourceName),
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["id"]), nil
+					}
+				}(),
+				ImportStateVerifyIdentifierAttribute: "id",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				// The secret key is obfuscated by the service
+				ImportStateVerifyIgnore: []string{
+					"recaptcha_secret_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDavinciConnectorInstancePingoneSso_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_connector_instance_pingone_sso.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciTypedConnectorInstance_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciConnectorInstancePingoneSso_HCL(resourceName, "secret-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "client_id", "00000000-0000-0000-0000-000000000000"),
+					resource.TestCheckResourceAttr(resourceFullName, "client_secret", "secret-1"),
+					resource.TestCheckResourceAttrPair(resourceFullName, "env_id", "data.pingone_environment.general_test", "id"),
+					resource.TestCheckResourceAttr(resourceFullName, "region", "NA"),
+				),
+			},
+			{
+				Config: davinciConnectorInstancePingoneSso_HCL(resourceName, "secret-2"),
+				Check:  resource.TestCheckResourceAttr(resourceFullName, "client_secret", "secret-2"),
+			},
+		},
+	})
+}
+
+func davinciConnectorInstanceHttp_MinimalHCL(resourceName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_connector_instance_http" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+}
+`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func davinciConnectorInstanceHttp_CompleteHCL(resourceName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_connector_instance_http" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+
+  recaptcha_secret_key = "test-secret-key"
+  recaptcha_site_key   = "test-site-key"
+}
+`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func davinciConnectorInstancePingoneSso_HCL(resourceName, clientSecret string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_connector_instance_pingone_sso" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+
+  client_id     = "00000000-0000-0000-0000-000000000000"
+  client_secret = "%[3]s"
+  env_id        = data.pingone_environment.general_test.id
+  region        = "NA"
+}
+`, acctest.GenericSandboxEnvironment(), resourceName, clientSecret)
+}
+
+func davinciTypedConnectorInstance_CheckDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+
+	p1Client, err := acctest.TestClient(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "pingone_davinci_connector_instance_") {
+			continue
+		}
+		shouldContinue, err := acctest.CheckParentEnvironmentDestroy(ctx, p1Client, rs.Primary.Attributes["environment_id"])
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		_, r, err := p1Client.DaVinciConnectorsApi.GetConnectorInstanceById(ctx, uuid.MustParse(rs.Primary.Attributes["environment_id"]), rs.Primary.Attributes["id"]).Execute()
+
+		shouldContinue, err = acctest.CheckForResourceDestroy(r, err)
+		if err != nil {
+			return err
+		}
+
+		if shouldContinue {
+			continue
+		}
+
+		return fmt.Errorf("PingOne %s Instance %s still exists", rs.Type, rs.Primary.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Some DaVinci resources add hand-written attributes to a generated resource, without changing the generated code.
+// The hand-written resource embeds the generated resource, and the davinciResourceExtension converts requests to the
+// generated resource schema, with the extension attributes removed, so that they can be delegated to the generated
+// resource.  The extension attribute values of the request are then added back to the new plan or state.
+
+type davinciResourceExtension struct {
+	baseSchema     schema.Schema
+	extendedSchema schema.Schema
+}
+
+// newDavinciResourceExtension returns the extension between the schema of the generated resource and the schema of
+// the resource that extends it
+func newDavinciResourceExtension(ctx context.Context, base, extended resource.Resource) (davinciResourceExtension, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	baseSchemaResp := resource.SchemaResponse{}
+	base.Schema(ctx, resource.SchemaRequest{}, &baseSchemaResp)
+	diags.Append(baseSchemaResp.Diagnostics...)
+
+	extendedSchemaResp := resource.SchemaResponse{}
+	extended.Schema(ctx, resource.SchemaRequest{}, &extendedSchemaResp)
+	diags.Append(extendedSchemaResp.Diagnostics...)
+
+	return davinciResourceExtension{
+		baseSchema:     baseSchemaResp.Schema,
+		extendedSchema: extendedSchemaResp.Schema,
+	}, diags
+}
+
+// baseValue returns the resource value with the extension attributes removed
+func (e davinciResourceExtension) baseValue(ctx context.Context, value tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	baseType := e.baseSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	if value.IsNull() {
+		return tftypes.NewValue(baseType, nil), diags
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(baseType, tftypes.UnknownValue), diags
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		diags.AddError(
+			"Unexpected resource value",
+			"Unable to read the resource value to remove the extension attributes: "+err.Error()+".  Please report this issue to the provider maintainers.",
+		)
+		return tftypes.NewValue(baseType, nil), diags
+	}
+
+	baseAttributes := make(map[string]tftypes.Value, len(baseType.AttributeTypes))
+	for name := range baseType.AttributeTypes {
+		baseAttributes[name] = attributes[name]
+	}
+
+	return tftypes.NewValue(baseType, baseAttributes), diags
+}
+
+// extendedValue returns the base resource value with the extension attribute values taken from the extended value.
+// The extension attributes are null when the extended value is null.  A null base value returns a null value.
+func (e davinciResourceExtension) extendedValue(ctx context.Context, baseValue, extendedValue tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	extendedType := e.extendedSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	if baseValue.IsNull() {
+		return tftypes.NewValue(extendedType, nil), diags
+	}
+
+	var baseAttributes, extendedAttributes map[string]tftypes.Value
+	if err := baseValue.As(&baseAttributes); err != nil {
+		diags.AddError(
+			"Unexpected resource value",
+			"Unable to read the resource value to add the extension attributes: "+err.Error()+".  Please report this issue to the provider maintainers.",
+		)
+		return tftypes.NewValue(extendedType, nil), diags
+	}
+	if !extendedValue.IsNull() && extendedValue.IsKnown() {
+		if err := extendedValue.As(&extendedAttributes); err != nil {
+			diags.AddError(
+				"Unexpected resource value",
+				"Unable to read the extension attribute values: "+err.Error()+".  Please report this issue to the provider maintainers.",
+			)
+			return tftypes.NewValue(extendedType, nil), diags
+		}
+	}
+
+	attributes := make(map[string]tftypes.Value, len(extendedType.AttributeTypes))
+	for name, attributeType := range extendedType.AttributeTypes {
+		if v, ok := baseAttributes[name]; ok {
+			attributes[name] = v
+		} else if v, ok := extendedAttributes[name]; ok {
+			attributes[name] = v
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	return tftypes.NewValue(extendedType, attributes), diags
+}
+
+func (e davinciResourceExtension) baseConfig(ctx context.Context, config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	raw, diags := e.baseValue(ctx, config.Raw)
+	return tfsdk.Config{Schema: e.baseSchema, Raw: raw}, diags
+}
+
+func (e davinciResourceExtension) basePlan(ctx context.Context, plan tfsdk.Plan) (tfsdk.Plan, diag.Diagnostics) {
+	raw, diags := e.baseValue(ctx, plan.Raw)
+	return tfsdk.Plan{Schema: e.baseSchema, Raw: raw}, diags
+}
+
+func (e davinciResourceExtension) baseState(ctx context.Context, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	raw, diags := e.baseValue(ctx, state.Raw)
+	return tfsdk.State{Schema: e.baseSchema, Raw: raw}, diags
+}
+
+// validateConfig delegates config validation to the generated resource
+func (e davinciResourceExtension) validateConfig(ctx context.Context, base resource.ResourceWithValidateConfig, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	baseConfig, diags := e.baseConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseResp := resource.ValidateConfigResponse{}
+	base.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config:             baseConfig,
+		ClientCapabilities: req.ClientCapabilities,
+	}, &baseResp)
+	resp.Diagnostics.Append(baseResp.Diagnostics...)
+}
+
+// modifyPlan delegates plan modification to the generated resource, keeping the planned extension attribute values
+func (e davinciResourceExtension) modifyPlan(ctx context.Context, base resource.ResourceWithModifyPlan, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var diags diag.Diagnostics
+
+	baseReq := resource.ModifyPlanRequest{
+		ProviderMeta:       req.ProviderMeta,
+		Private:            req.Private,
+		ClientCapabilities: req.ClientCapabilities,
+	}
+
+	baseReq.Config, diags = e.baseConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	baseReq.Plan, diags = e.basePlan(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	baseReq.State, diags = e.baseState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	basePlan, diags := e.basePlan(ctx, resp.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseResp := resource.ModifyPlanResponse{
+		Plan:            basePlan,
+		RequiresReplace: resp.RequiresReplace,
+		Private:         resp.Private,
+	}
+	base.ModifyPlan(ctx, baseReq, &baseResp)
+	resp.Diagnostics.Append(baseResp.Diagnostics...)
+	resp.RequiresReplace = baseResp.RequiresReplace
+	resp.Private = baseResp.Private
+	resp.Deferred = baseResp.Deferred
+
+	resp.Plan.Raw, diags = e.extendedValue(ctx, baseResp.Plan.Raw, resp.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+}
+
+// create delegates resource creation to the generated resource, taking the extension attribute values from the plan
+func (e davinciResourceExtension) create(ctx context.Context, base resource.Resource, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var diags diag.Diagnostics
+
+	baseReq := resource.CreateRequest{
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	baseReq.Config, diags = e.baseConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	baseReq.Plan, diags = e.basePlan(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseState, diags := e.baseState(ctx, resp.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseResp := resource.CreateResponse{
+		State:   baseState,
+		Private: resp.Private,
+	}
+	base.Create(ctx, baseReq, &baseResp)
+	resp.Diagnostics.Append(baseResp.Diagnostics...)
+	resp.Private = baseResp.Private
+
+	resp.State.Raw, diags = e.extendedValue(ctx, baseResp.State.Raw, req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+}
+
+// read delegates reading the resource to the generated resource, taking the extension attribute values from the prior
+// state
+func (e davinciResourceExtension) read(ctx context.Context, base resource.Resource, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var diags diag.Diagnostics
+
+	baseReq := resource.ReadRequest{
+		Private:            req.Private,
+		ProviderMeta:       req.ProviderMeta,
+		ClientCapabilities: req.ClientCapabilities,
+	}
+
+	baseReq.State, diags = e.baseState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseState, diags := e.baseState(ctx, resp.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseResp := resource.ReadResponse{
+		State:   baseState,
+		Private: resp.Private,
+	}
+	base.Read(ctx, baseReq, &baseResp)
+	resp.Diagnostics.Append(baseResp.Diagnostics...)
+	resp.Private = baseResp.Private
+	resp.Deferred = baseResp.Deferred
+
+	resp.State.Raw, diags = e.extendedValue(ctx, baseResp.State.Raw, req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+}
+
+// update delegates resource updates to the generated resource, taking the extension attribute values from the plan
+func (e davinciResourceExtension) update(ctx context.Context, base resource.Resource, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+
+	baseReq := resource.UpdateRequest{
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}
+
+	baseReq.Config, diags = e.baseConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	baseReq.Plan, diags = e.basePlan(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	baseReq.State, diags = e.baseState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseState, diags := e.baseState(ctx, resp.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseResp := resource.UpdateResponse{
+		State:   baseState,
+		Private: resp.Private,
+	}
+	base.Update(ctx, baseReq, &baseResp)
+	resp.Diagnostics.Append(baseResp.Diagnostics...)
+	resp.Private = baseResp.Private
+
+	resp.State.Raw, diags = e.extendedValue(ctx, baseResp.State.Raw, req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+}
+
+// delete delegates resource deletion to the generated resource
+func (e davinciResourceExtension) delete(ctx context.Context, base resource.Resource, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	baseState, diags := e.baseState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseResp := resource.DeleteResponse{
+		State:   baseState,
+		Private: resp.Private,
+	}
+	base.Delete(ctx, resource.DeleteRequest{
+		State:        baseState,
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &baseResp)
+	resp.Diagnostics.Append(baseResp.Diagnostics...)
+	resp.Private = baseResp.Private
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -16,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pingidentity/pingone-go-client/pingone"
@@ -131,6 +136,632 @@ func (r *davinciFlowResource) ValidateConfig(ctx context.Context, req resource.V
 			)
 		}
 	}
+
+	// Validate the structure of the flow graph
+	resp.Diagnostics.Append(data.validateGraphData()...)
+}
+
+// validateGraphData checks that the configured flow graph is internally consistent, so that mistakes are reported
+// against the offending attribute at plan time rather than as an API error at apply time.  Values that are not yet
+// known are skipped.
+func (m *davinciFlowResourceModel) validateGraphData() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodes := m.getGraphDataElementsNodes()
+	if nodes == nil || nodes.IsNull() || nodes.IsUnknown() {
+		return diags
+	}
+	nodesPath := path.Root("graph_data").AtName("elements").AtName("nodes")
+	edgesPath := path.Root("graph_data").AtName("elements").AtName("edges")
+
+	nodeKeys := make([]string, 0, len(nodes.Elements()))
+	for nodeKey := range nodes.Elements() {
+		nodeKeys = append(nodeKeys, nodeKey)
+	}
+	slices.Sort(nodeKeys)
+
+	// Nodes must have a unique id_unique value
+	idUniqueNodeKeys := map[string]string{}
+	annotationNodes := map[string]bool{}
+	teleportNodes := map[string]bool{}
+	for _, nodeKey := range nodeKeys {
+		nodeData := graphDataNodeDataAttributes(nodes.Elements()[nodeKey])
+		if nodeData == nil {
+			continue
+		}
+
+		if nodeType, ok := nodeData["node_type"].(types.String); ok && nodeType.ValueString() == "ANNOTATION" {
+			annotationNodes[nodeKey] = true
+		}
+
+		if isDavinciFlowTeleportNode(nodeData) {
+			teleportNodes[nodeKey] = true
+		}
+
+		idUnique, ok := nodeData["id_unique"].(types.String)
+		if !ok || idUnique.IsNull() || idUnique.IsUnknown() {
+			continue
+		}
+		if otherNodeKey, exists := idUniqueNodeKeys[idUnique.ValueString()]; exists {
+			diags.AddAttributeError(
+				nodesPath.AtMapKey(nodeKey).AtName("data").AtName("id_unique"),
+				"Invalid DaVinci flow graph",
+				fmt.Sprintf("The id_unique value %q of node %q is already used by node %q.  Each node in the flow must have a unique id_unique value.", idUnique.ValueString(), nodeKey, otherNodeKey),
+			)
+			continue
+		}
+		idUniqueNodeKeys[idUnique.ValueString()] = nodeKey
+	}
+
+	edgesValue, ok := m.GraphData.Attributes()["elements"].(types.Object).Attributes()["edges"].(types.Map)
+	if !ok || edgesValue.IsUnknown() {
+		return diags
+	}
+
+	edgeKeys := make([]string, 0, len(edgesValue.Elements()))
+	for edgeKey := range edgesValue.Elements() {
+		edgeKeys = append(edgeKeys, edgeKey)
+	}
+	slices.Sort(edgeKeys)
+
+	// Each edge must connect two nodes of the flow
+	edgesComplete := true
+	targets := map[string][]string{}
+	for _, edgeKey := range edgeKeys {
+		edge, ok := edgesValue.Elements()[edgeKey].(types.Object)
+		if !ok || edge.IsNull() || edge.IsUnknown() {
+			edgesComplete = false
+			continue
+		}
+		edgeData, ok := edge.Attributes()["data"].(types.Object)
+		if !ok || edgeData.IsNull() || edgeData.IsUnknown() {
+			edgesComplete = false
+			continue
+		}
+
+		endpoints := map[string]string{}
+		for _, endpoint := range []string{"source", "target"} {
+			v, ok := edgeData.Attributes()[endpoint].(types.String)
+			if !ok || v.IsNull() || v.IsUnknown() {
+				edgesComplete = false
+				continue
+			}
+
+			if _, ok := nodes.Elements()[v.ValueString()]; !ok {
+				diags.AddAttributeError(
+					edgesPath.AtMapKey(edgeKey).AtName("data").AtName(endpoint),
+					"Invalid DaVinci flow graph",
+					fmt.Sprintf("The %s %q of edge %q does not reference a node in graph_data.elements.nodes.  The value must be the map key of a node in the flow.", endpoint, v.ValueString(), edgeKey),
+				)
+				continue
+			}
+			endpoints[endpoint] = v.ValueString()
+		}
+
+		source, sourceOk := endpoints["source"]
+		target, targetOk := endpoints["target"]
+		if sourceOk && targetOk && !annotationNodes[source] && !annotationNodes[target] {
+			targets[source] = append(targets[source], target)
+		}
+	}
+
+	// The remaining checks can only be made when the whole graph is known and valid.  They find nodes that may never
+	// run, which is usually a mistake but is valid in DaVinci, so they are reported as warnings.
+	if !edgesComplete || diags.HasError() {
+		return diags
+	}
+
+	flowNodeKeys := make([]string, 0, len(nodeKeys))
+	for _, nodeKey := range nodeKeys {
+		if !annotationNodes[nodeKey] {
+			flowNodeKeys = append(flowNodeKeys, nodeKey)
+		}
+	}
+
+	structure := analyseDavinciFlowGraph(flowNodeKeys, teleportNodes, targets)
+
+	for _, nodeKey := range structure.orphanedNodes {
+		diags.AddAttributeWarning(
+			nodesPath.AtMapKey(nodeKey),
+			"Orphaned DaVinci flow node",
+			fmt.Sprintf("The node %q is not connected to any other node in the flow by an edge, so it may never run.  Connect the node to the flow with an edge, or remove it.", nodeKey),
+		)
+	}
+
+	if len(structure.startNodes) > 1 {
+		diags.AddAttributeWarning(
+			nodesPath,
+			"Multiple DaVinci flow start nodes",
+			fmt.Sprintf("The nodes \"%s\" have no inbound edge, so the flow has more than one start node.  Check that each of these nodes is intended to start a path of the flow.", strings.Join(structure.startNodes, "\", \"")),
+		)
+	}
+
+	for _, nodeKey := range structure.unreachableNodes {
+		diags.AddAttributeWarning(
+			nodesPath.AtMapKey(nodeKey),
+			"Unreachable DaVinci flow node",
+			fmt.Sprintf("The node %q cannot be reached by edges from a start node of the flow, so it may never run.", nodeKey),
+		)
+	}
+
+	return diags
+}
+
+// isDavinciFlowTeleportNode returns whether the node data is that of a teleport node.  Teleport nodes move the flow
+// between nodes without an edge, so they are entry points of the flow graph.
+func isDavinciFlowTeleportNode(nodeData map[string]attr.Value) bool {
+	connectorId, ok := nodeData["connector_id"].(types.String)
+	if !ok || connectorId.ValueString() != "nodeConnector" {
+		return false
+	}
+
+	capabilityName, ok := nodeData["capability_name"].(types.String)
+	return ok && (capabilityName.ValueString() == "startNode" || capabilityName.ValueString() == "goToNode")
+}
+
+// davinciFlowGraphStructure describes how the nodes of a flow are connected
+type davinciFlowGraphStructure struct {
+	// Nodes, other than teleport nodes, that are not the source or target of any edge
+	orphanedNodes []string
+	// Connected nodes, other than teleport nodes, that no edge leads to.  These are where the flow starts.
+	startNodes []string
+	// Connected nodes that cannot be reached from a start node or teleport node
+	unreachableNodes []string
+}
+
+// analyseDavinciFlowGraph finds the orphaned, start and unreachable nodes of a flow graph.  The flow trigger does not
+// reference a node in the graph, so a flow starts at a connected node that no edge leads to, and teleport nodes are
+// further entry points that the flow can reach without an edge.  nodeKeys are the keys of the flow's nodes, excluding
+// annotations, in the order that results are returned, teleportNodes are the keys of the teleport nodes, and targets
+// maps each node key to the targets of the edges that leave it.
+func analyseDavinciFlowGraph(nodeKeys []string, teleportNodes map[string]bool, targets map[string][]string) davinciFlowGraphStructure {
+	structure := davinciFlowGraphStructure{
+		orphanedNodes:    make([]string, 0),
+		startNodes:       make([]string, 0),
+		unreachableNodes: make([]string, 0),
+	}
+
+	// A flow of a single node starts and ends with that node
+	if len(nodeKeys) <= 1 {
+		structure.startNodes = append(structure.startNodes, nodeKeys...)
+		return structure
+	}
+
+	connectedNodes := map[string]bool{}
+	hasInboundEdge := map[string]bool{}
+	for source, sourceTargets := range targets {
+		connectedNodes[source] = true
+		for _, target := range sourceTargets {
+			connectedNodes[target] = true
+			hasInboundEdge[target] = true
+		}
+	}
+
+	reachable := map[string]bool{}
+	queue := make([]string, 0)
+	for _, nodeKey := range nodeKeys {
+		switch {
+		case teleportNodes[nodeKey]:
+		case !connectedNodes[nodeKey]:
+			structure.orphanedNodes = append(structure.orphanedNodes, nodeKey)
+			continue
+		case !hasInboundEdge[nodeKey]:
+			structure.startNodes = append(structure.startNodes, nodeKey)
+		default:
+			continue
+		}
+
+		reachable[nodeKey] = true
+		queue = append(queue, nodeKey)
+	}
+
+	for len(queue) > 0 {
+		nodeKey := queue[0]
+		queue = queue[1:]
+		for _, target := range targets[nodeKey] {
+			if !reachable[target] {
+				reachable[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	for _, nodeKey := range nodeKeys {
+		if connectedNodes[nodeKey] && !reachable[nodeKey] {
+			structure.unreachableNodes = append(structure.unreachableNodes, nodeKey)
+		}
+	}
+
+	return structure
+}
+
+// validateGraphDataConnections checks that each known node connection_id references a connector instance that
+// exists in the environment
+func (r *davinciFlowResource) validateGraphDataConnections(ctx context.Context, plan *davinciFlowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodes := plan.getGraphDataElementsNodes()
+	if nodes == nil || nodes.IsNull() || nodes.IsUnknown() || plan.EnvironmentId.IsUnknown() {
+		return diags
+	}
+
+	connectionIdNodeKeys := map[string][]string{}
+	for nodeKey, node := range nodes.Elements() {
+		nodeData := graphDataNodeDataAttributes(node)
+		if nodeData == nil {
+			continue
+		}
+
+		connectionId, ok := nodeData["connection_id"].(types.String)
+		if !ok || connectionId.IsNull() || connectionId.IsUnknown() || connectionId.ValueString() == "" {
+			continue
+		}
+		connectionIdNodeKeys[connectionId.ValueString()] = append(connectionIdNodeKeys[connectionId.ValueString()], nodeKey)
+	}
+
+	if len(connectionIdNodeKeys) == 0 {
+		return diags
+	}
+
+	environmentIdUuid, err := uuid.Parse(plan.EnvironmentId.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	var responseData *pingone.DaVinciConnectorInstanceCollectionResponse
+	listDiags := framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciConnectorsApi.GetConnectorInstances(ctx, environmentIdUuid).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"GetConnectorInstances",
+		framework.DefaultCustomError,
+		framework.DefaultCreateReadRetryable,
+		&responseData,
+	)
+	if listDiags.HasError() || responseData == nil {
+		// The check is advisory, so a failure to list connector instances must not block the plan
+		tflog.Warn(ctx, "Unable to list DaVinci connector instances to validate flow node connections", map[string]interface{}{
+			"environment_id": plan.EnvironmentId.ValueString(),
+		})
+		return diags
+	}
+
+	connectorInstances := map[string]bool{}
+	for _, connectorInstance := range responseData.Embedded.ConnectorInstances {
+		connectorInstances[connectorInstance.Id] = true
+	}
+
+	connectionIds := make([]string, 0, len(connectionIdNodeKeys))
+	for connectionId := range connectionIdNodeKeys {
+		connectionIds = append(connectionIds, connectionId)
+	}
+	slices.Sort(connectionIds)
+
+	for _, connectionId := range connectionIds {
+		if connectorInstances[connectionId] {
+			continue
+		}
+
+		nodeKeys := connectionIdNodeKeys[connectionId]
+		slices.Sort(nodeKeys)
+		for _, nodeKey := range nodeKeys {
+			diags.AddAttributeError(
+				path.Root("graph_data").AtName("elements").AtName("nodes").AtMapKey(nodeKey).AtName("data").AtName("connection_id"),
+				"Invalid DaVinci flow graph",
+				fmt.Sprintf("The connection_id %q of node %q does not reference a connector instance in environment %q.", connectionId, nodeKey, plan.EnvironmentId.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// graphDataSubflowReferences returns the node keys of the flow connector nodes that call each subflow, keyed by the
+// subflow ID.  The result is not known if any node that may call a subflow is not known.
+func (m *davinciFlowResourceModel) graphDataSubflowReferences() (map[string][]string, bool) {
+	if m.GraphData.IsUnknown() {
+		return nil, false
+	}
+	if !m.GraphData.IsNull() && m.GraphData.Attributes()["elements"].IsUnknown() {
+		return nil, false
+	}
+
+	nodes := m.getGraphDataElementsNodes()
+	if nodes == nil || nodes.IsNull() {
+		return map[string][]string{}, true
+	}
+	if nodes.IsUnknown() {
+		return nil, false
+	}
+
+	subflowNodeKeys := map[string][]string{}
+	for nodeKey, node := range nodes.Elements() {
+		if node.IsUnknown() {
+			return nil, false
+		}
+
+		nodeData := graphDataNodeDataAttributes(node)
+		if nodeData == nil {
+			continue
+		}
+
+		connectorId, ok := nodeData["connector_id"].(types.String)
+		if !ok || connectorId.IsUnknown() {
+			return nil, false
+		}
+		if connectorId.ValueString() != "flowConnector" {
+			continue
+		}
+
+		properties, ok := nodeData["properties"].(jsontypes.Normalized)
+		if !ok || properties.IsUnknown() {
+			return nil, false
+		}
+		if properties.IsNull() {
+			continue
+		}
+
+		// Subflows are referenced as {"subFlowId": {"value": {"label": "<name>", "value": "<flow ID>"}}}
+		var propertiesMap map[string]any
+		if err := json.Unmarshal([]byte(properties.ValueString()), &propertiesMap); err != nil {
+			continue
+		}
+		subflowProperty, _ := propertiesMap["subFlowId"].(map[string]any)
+		subflowValue, _ := subflowProperty["value"].(map[string]any)
+		if subflowId, ok := subflowValue["value"].(string); ok && subflowId != "" {
+			subflowNodeKeys[subflowId] = append(subflowNodeKeys[subflowId], nodeKey)
+		}
+	}
+
+	return subflowNodeKeys, true
+}
+
+// graphDataSubflowIds returns the IDs of the subflows called by the flow
+func (m *davinciFlowResourceModel) graphDataSubflowIds() types.Set {
+	subflowNodeKeys, ok := m.graphDataSubflowReferences()
+	if !ok {
+		return types.SetUnknown(types.StringType)
+	}
+
+	subflowIds := make([]attr.Value, 0, len(subflowNodeKeys))
+	for _, subflowId := range slices.Sorted(maps.Keys(subflowNodeKeys)) {
+		subflowIds = append(subflowIds, types.StringValue(subflowId))
+	}
+
+	return types.SetValueMust(types.StringType, subflowIds)
+}
+
+// davinciFlowWithSubflowsResource extends the generated pingone_davinci_flow resource with the computed `subflow_ids`
+// attribute, derived from the flow connector nodes of `graph_data`.  All other requests are delegated to the generated
+// resource.
+type davinciFlowWithSubflowsResource struct {
+	davinciFlowResource
+}
+
+type davinciFlowWithSubflowsResourceModel struct {
+	davinciFlowResourceModel
+	SubflowIds types.Set `tfsdk:"subflow_ids"`
+}
+
+var (
+	_ resource.Resource                   = &davinciFlowWithSubflowsResource{}
+	_ resource.ResourceWithConfigure      = &davinciFlowWithSubflowsResource{}
+	_ resource.ResourceWithImportState    = &davinciFlowWithSubflowsResource{}
+	_ resource.ResourceWithValidateConfig = &davinciFlowWithSubflowsResource{}
+	_ resource.ResourceWithModifyPlan     = &davinciFlowWithSubflowsResource{}
+)
+
+func NewDavinciFlowWithSubflowsResource() resource.Resource {
+	return &davinciFlowWithSubflowsResource{}
+}
+
+func (r *davinciFlowWithSubflowsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.davinciFlowResource.Schema(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Schema.Attributes["subflow_ids"] = schema.SetAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		Description:         "The IDs of the subflows called by the flow, parsed from the \"subFlowId\" property of flow connector nodes in \"graph_data\".",
+		MarkdownDescription: "The IDs of the subflows called by the flow, parsed from the `subFlowId` property of flow connector nodes in `graph_data`.",
+	}
+}
+
+func (r *davinciFlowWithSubflowsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciFlowResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.validateConfig(ctx, &r.davinciFlowResource, req, resp)
+}
+
+func (r *davinciFlowWithSubflowsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciFlowResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.modifyPlan(ctx, &r.davinciFlowResource, req, resp)
+	if resp.Diagnostics.HasError() || resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Subflow references are derived from the planned graph
+	var plan davinciFlowWithSubflowsResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if subflowIds := plan.graphDataSubflowIds(); !subflowIds.Equal(plan.SubflowIds) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("subflow_ids"), subflowIds)...)
+	}
+}
+
+func (r *davinciFlowWithSubflowsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciFlowResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.create(ctx, &r.davinciFlowResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDavinciFlowStateSubflowIds(ctx, &resp.State)...)
+}
+
+func (r *davinciFlowWithSubflowsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciFlowResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.read(ctx, &r.davinciFlowResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDavinciFlowStateSubflowIds(ctx, &resp.State)...)
+}
+
+func (r *davinciFlowWithSubflowsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciFlowResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.update(ctx, &r.davinciFlowResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDavinciFlowStateSubflowIds(ctx, &resp.State)...)
+}
+
+func (r *davinciFlowWithSubflowsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciFlowResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.delete(ctx, &r.davinciFlowResource, req, resp)
+}
+
+// setDavinciFlowStateSubflowIds sets `subflow_ids` from the `graph_data` in state
+func setDavinciFlowStateSubflowIds(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The resource has been removed from state
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	var data davinciFlowWithSubflowsResourceModel
+	diags.Append(state.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("subflow_ids"), data.graphDataSubflowIds())...)
+
+	return diags
+}
+
+// validateGraphDataSubflows warns when a subflow called by the flow does not exist in the flow's environment, or is
+// not enabled, as the flow would fail when the subflow is called
+func (r *davinciFlowResource) validateGraphDataSubflows(ctx context.Context, plan *davinciFlowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	subflowNodeKeys, ok := plan.graphDataSubflowReferences()
+	if !ok || len(subflowNodeKeys) == 0 || plan.EnvironmentId.IsUnknown() {
+		return diags
+	}
+
+	environmentIdUuid, err := uuid.Parse(plan.EnvironmentId.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	for _, subflowId := range slices.Sorted(maps.Keys(subflowNodeKeys)) {
+		// A flow that calls itself does not need to be checked
+		if !plan.Id.IsUnknown() && plan.Id.ValueString() == subflowId {
+			continue
+		}
+
+		var responseData *pingone.DaVinciFlowResponse
+		getDiags := framework.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.DaVinciFlowsApi.GetFlowById(ctx, environmentIdUuid, subflowId).Execute()
+				return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"GetFlowById",
+			framework.CustomErrorResourceNotFoundWarning,
+			framework.DefaultCreateReadRetryable,
+			&responseData,
+		)
+		if getDiags.HasError() {
+			// The check is advisory, so a failure to retrieve the subflow must not block the plan
+			tflog.Warn(ctx, "Unable to retrieve DaVinci subflow to validate flow node subflow references", map[string]interface{}{
+				"environment_id": plan.EnvironmentId.ValueString(),
+				"subflow_id":     subflowId,
+			})
+			continue
+		}
+
+		nodeKeys := subflowNodeKeys[subflowId]
+		slices.Sort(nodeKeys)
+
+		for _, nodeKey := range nodeKeys {
+			propertiesPath := path.Root("graph_data").AtName("elements").AtName("nodes").AtMapKey(nodeKey).AtName("data").AtName("properties")
+
+			if responseData == nil {
+				diags.AddAttributeWarning(
+					propertiesPath,
+					"DaVinci subflow not found",
+					fmt.Sprintf("The subflow %q called by node %q does not exist in environment %q.  Subflows must be in the same environment as the flow that calls them.", subflowId, nodeKey, plan.EnvironmentId.ValueString()),
+				)
+			} else if responseData.Enabled != nil && !*responseData.Enabled {
+				diags.AddAttributeWarning(
+					propertiesPath,
+					"DaVinci subflow not enabled",
+					fmt.Sprintf("The subflow %q called by node %q is not enabled, so the flow will fail when the subflow is called.", subflowId, nodeKey),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// graphDataNodeDataAttributes returns the attributes of a node's data object, or nil if they are not known
+func graphDataNodeDataAttributes(node attr.Value) map[string]attr.Value {
+	nodeObject, ok := node.(types.Object)
+	if !ok || nodeObject.IsNull() || nodeObject.IsUnknown() {
+		return nil
+	}
+
+	nodeData, ok := nodeObject.Attributes()["data"].(types.Object)
+	if !ok || nodeData.IsNull() || nodeData.IsUnknown() {
+		return nil
+	}
+
+	return nodeData.Attributes()
 }
 
 func (r *davinciFlowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -156,6 +787,20 @@ func (r *davinciFlowResource) ModifyPlan(ctx context.Context, req resource.Modif
 		}
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 	}
+
+	// Check that node connections exist when the graph is created or changed
+	if r.Client != nil {
+		var state *davinciFlowResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state == nil || !state.GraphData.Equal(plan.GraphData) {
+			resp.Diagnostics.Append(r.validateGraphDataConnections(ctx, plan)...)
+			resp.Diagnostics.Append(r.validateGraphDataSubflows(ctx, plan)...)
+		}
+	}
 }
 
 func (m *davinciFlowResourceModel) getGraphDataElementsNodes() *types.Map {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -68,10 +69,11 @@ func (r *davinciFlowDeployResource) Configure(ctx context.Context, req resource.
 }
 
 type davinciFlowDeployResourceModel struct {
-	EnvironmentId       types.String `tfsdk:"environment_id"`
-	FlowId              types.String `tfsdk:"flow_id"`
-	Id                  types.String `tfsdk:"id"`
-	DeployTriggerValues types.Map    `tfsdk:"deploy_trigger_values"`
+	EnvironmentId       types.String  `tfsdk:"environment_id"`
+	FlowId              types.String  `tfsdk:"flow_id"`
+	Id                  types.String  `tfsdk:"id"`
+	DeployTriggerValues types.Map     `tfsdk:"deploy_trigger_values"`
+	Version             types.Float32 `tfsdk:"version"`
 }
 
 func (r *davinciFlowDeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -110,6 +112,14 @@ func (r *davinciFlowDeployResource) Schema(ctx context.Context, req resource.Sch
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"version": schema.Float32Attribute{
+				Description:         "A historical version of the flow to roll back to and deploy. When not set, the current version of the flow is deployed. Changing this value will trigger a deployment of the flow.",
+				MarkdownDescription: "A historical version of the flow to roll back to and deploy.  The versions of a flow can be retrieved with the `pingone_davinci_flow_versions` data source.  When not set, the current version of the flow is deployed.  Changing this value will trigger a deployment of the flow.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Float32{
+					float32planmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -182,6 +192,14 @@ func (r *davinciFlowDeployResource) Create(ctx context.Context, req resource.Cre
 		)
 		return
 	}
+	// Roll the flow back to the pinned version, so that the version is deployed
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		resp.Diagnostics.Append(r.revertFlowVersion(ctx, environmentIdUuid, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var responseData *pingone.DaVinciFlowResponse
 	resp.Diagnostics.Append(framework.ParseResponse(
 		ctx,
@@ -212,6 +230,60 @@ func (r *davinciFlowDeployResource) Create(ctx context.Context, req resource.Cre
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// revertFlowVersion reverts the flow to the configured version using the revert link of the version, which is not
+// modelled by the client
+func (r *davinciFlowDeployResource) revertFlowVersion(ctx context.Context, environmentIdUuid uuid.UUID, data davinciFlowDeployResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	versionId := davinciFlowVersionString(data.Version.ValueFloat32())
+
+	var versionData *pingone.DaVinciFlowVersionResponse
+	diags.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciFlowVersionsApi.GetVersionByIdUsingFlowId(ctx, environmentIdUuid, data.FlowId.ValueString(), versionId).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"GetVersionByIdUsingFlowId",
+		framework.DefaultCustomError,
+		framework.DefaultCreateReadRetryable,
+		&versionData,
+	)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if versionData == nil || versionData.Links.FlowRevert.Href == "" {
+		diags.AddAttributeError(
+			path.Root("version"),
+			"Cannot roll back DaVinci flow",
+			fmt.Sprintf("Version %s of flow %s cannot be rolled back to.", versionId, data.FlowId.ValueString()),
+		)
+		return diags
+	}
+
+	contentType := "application/vnd.pingidentity.flowversion.revert+json"
+	if versionData.Links.FlowRevert.Type != nil && *versionData.Links.FlowRevert.Type != "" {
+		contentType = *versionData.Links.FlowRevert.Type
+	}
+
+	diags.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := framework.APIRawRequest(ctx, r.Client, http.MethodPost, versionData.Links.FlowRevert.Href, contentType, map[string]interface{}{}, nil)
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), nil, fR, fErr)
+		},
+		"RevertFlowVersion",
+		framework.DefaultCustomError,
+		nil,
+		nil,
+	)...)
+
+	return diags
+}
+
 func (r *davinciFlowDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data davinciFlowDeployResourceModel
 
@@ -259,6 +259,45 @@ func TestAccDavinciFlowDeploy_BadParameters(t *testing.T) {
 	})
 }
 
+func TestAccDavinciFlowDeploy_Version(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_flow_deploy.%s", resourceName)
+	var lastDeployTime string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciFlow_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				// Initial deploy of the current version
+				Config: davinciFlowDeploy_FirstDeployHCL(t, resourceName, false),
+				Check:  davinciFlowDeploy_GetDeployedTimestamp(&lastDeployTime),
+			},
+			{
+				// A version that does not exist cannot be deployed
+				Config:      davinciFlowDeploy_VersionHCL(t, resourceName, "9999"),
+				ExpectError: regexp.MustCompile("Error when calling `GetVersionByIdUsingFlowId`"),
+			},
+			{
+				// Pinning a version redeploys the flow
+				Config: davinciFlowDeploy_VersionHCL(t, resourceName, fmt.Sprintf("data.pingone_davinci_flow_versions.%s.versions[length(data.pingone_davinci_flow_versions.%s.versions) - 1].version", resourceName, resourceName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceFullName, "version"),
+					davinciFlowDeploy_checkExpectedDeployTimestamp(true, &lastDeployTime),
+				),
+			},
+		},
+	})
+}
+
 func davinciFlowDeploy_GetIDs(resourceName string, environmentId, id *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -397,6 +436,23 @@ resource "pingone_davinci_flow_deploy" "%[2]s" {
 `, davinciFlowDeploy_FlowOnlyHCL(t, resourceName, withBootstrap), resourceName)
 }
 
+func davinciFlowDeploy_VersionHCL(t *testing.T, resourceName, version string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+data "pingone_davinci_flow_versions" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  flow_id        = pingone_davinci_flow.%[2]s.id
+}
+
+resource "pingone_davinci_flow_deploy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  flow_id        = pingone_davinci_flow.%[2]s.id
+  version        = %[3]s
+}
+`, davinciFlowDeploy_FlowOnlyHCL(t, resourceName, false), resourceName, version)
+}
+
 func davinciFlowDeploy_NewEnvHCL(environmentName, licenseID, resourceName string) string {
 	return fmt.Sprintf(`
 		%[1]s
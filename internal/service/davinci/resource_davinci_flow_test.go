@@ -0,0 +1,156 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+)
+
+func TestAccDavinciFlow_InvalidGraph(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciFlow_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Edge target does not reference a node
+			{
+				Config:      davinciFlow_GraphHCL(resourceName, "8n3jzbt4a2", "id-unique-1", "id-unique-2", fmt.Sprintf("pingone_davinci_connector_instance.%s-errors.id", resourceName)),
+				ExpectError: regexp.MustCompile(`The target "8n3jzbt4a2" of edge "ms8ahj3a1j" does not reference a node`),
+			},
+			// Duplicate id_unique values
+			{
+				Config:      davinciFlow_GraphHCL(resourceName, "8n3jzbt4a1", "id-unique-1", "id-unique-1", fmt.Sprintf("pingone_davinci_connector_instance.%s-errors.id", resourceName)),
+				ExpectError: regexp.MustCompile(`The id_unique value "id-unique-1" of node "8n3jzbt4a1" is already used by node\s+"2pzouq7el7"`),
+			},
+			// Connection ID does not reference a connector instance
+			{
+				Config:      davinciFlow_GraphHCL(resourceName, "8n3jzbt4a1", "id-unique-1", "id-unique-2", `"0123456789abcdef0123456789abcdef"`),
+				ExpectError: regexp.MustCompile(`The connection_id "0123456789abcdef0123456789abcdef" of node "2pzouq7el7" does\s+not reference a connector instance`),
+			},
+			// Valid graph
+			{
+				Config: davinciFlow_GraphHCL(resourceName, "8n3jzbt4a1", "id-unique-1", "id-unique-2", fmt.Sprintf("pingone_davinci_connector_instance.%s-errors.id", resourceName)),
+				Check:  resource.TestCheckResourceAttr(fmt.Sprintf("pingone_davinci_flow.%s", resourceName), "graph_data.elements.edges.ms8ahj3a1j.data.target", "8n3jzbt4a1"),
+			},
+		},
+	})
+}
+
+func TestAccDavinciFlow_SubflowIds(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_flow.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciFlow_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciFlow_FullBasicHCL(t, resourceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "subflow_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceFullName, "subflow_ids.*", fmt.Sprintf("pingone_davinci_flow.%s-subflow1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceFullName, "subflow_ids.*", fmt.Sprintf("pingone_davinci_flow.%s-subflow2", resourceName), "id"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("pingone_davinci_flow.%s-subflow1", resourceName), "subflow_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Two node flow HCL, where the edge target, node id_unique values and the first node's connection_id can be set
+func davinciFlow_GraphHCL(resourceName, edgeTarget, firstIdUnique, secondIdUnique, firstConnectionId string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_connector_instance" "%[2]s-errors" {
+  environment_id = data.pingone_environment.general_test.id
+  connector = {
+    id = "errorConnector"
+  }
+  name = "%[2]s-errors"
+}
+
+resource "pingone_davinci_flow" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+
+  graph_data = {
+    elements = {
+      nodes = {
+        "2pzouq7el7" = {
+          data = {
+            node_type       = "CONNECTION"
+            connection_id   = %[6]s
+            connector_id    = "errorConnector"
+            id_unique       = "%[4]s"
+            label           = "Error Message"
+            status          = "configured"
+            capability_name = "customErrorMessage"
+            type            = "action"
+            properties = jsonencode({
+              "error_message" : {
+                "value" : "This is an error"
+              }
+            })
+          }
+          position = {
+            x = 400
+            y = 400
+          }
+        }
+        "8n3jzbt4a1" = {
+          data = {
+            node_type       = "CONNECTION"
+            connection_id   = pingone_davinci_connector_instance.%[2]s-errors.id
+            connector_id    = "errorConnector"
+            id_unique       = "%[5]s"
+            label           = "Error Message"
+            status          = "configured"
+            capability_name = "customErrorMessage"
+            type            = "action"
+            properties = jsonencode({
+              "error_message" : {
+                "value" : "This is another error"
+              }
+            })
+          }
+          position = {
+            x = 600
+            y = 400
+          }
+        }
+      }
+      edges = {
+        "ms8ahj3a1j" = {
+          data = {
+            source = "2pzouq7el7"
+            target = "%[3]s"
+          }
+        }
+      }
+    }
+  }
+}`, acctest.GenericSandboxEnvironment(), resourceName, edgeTarget, firstIdUnique, secondIdUnique, firstConnectionId)
+}
@@ -5,9 +5,24 @@ package davinci
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/pingone-go-client/pingone"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
 )
 
 var (
@@ -102,9 +117,108 @@ func (r *davinciVariableResource) ValidateConfig(ctx context.Context, req resour
 			}
 		}
 	}
+}
+
+// davinciVariableWithValueOptionsResource extends the generated pingone_davinci_variable resource with the write-only
+// `value_wo` and `value_wo_version` attributes, and the `ignore_runtime_value_changes` attribute.  Requests are
+// delegated to the generated resource, with the planned `value` replaced by the write-only value or the runtime value
+// where needed.
+type davinciVariableWithValueOptionsResource struct {
+	davinciVariableResource
+}
+
+type davinciVariableWithValueOptionsResourceModel struct {
+	davinciVariableResourceModel
+	IgnoreRuntimeValueChanges types.Bool   `tfsdk:"ignore_runtime_value_changes"`
+	ValueWo                   types.String `tfsdk:"value_wo"`
+	ValueWoVersion            types.Int32  `tfsdk:"value_wo_version"`
+}
+
+var (
+	_ resource.Resource                   = &davinciVariableWithValueOptionsResource{}
+	_ resource.ResourceWithConfigure      = &davinciVariableWithValueOptionsResource{}
+	_ resource.ResourceWithImportState    = &davinciVariableWithValueOptionsResource{}
+	_ resource.ResourceWithValidateConfig = &davinciVariableWithValueOptionsResource{}
+
+	davinciVariableValueAttrTypes = map[string]attr.Type{
+		"bool":          types.BoolType,
+		"float32":       types.Float32Type,
+		"json_object":   jsontypes.NormalizedType{},
+		"string":        types.StringType,
+		"secret_string": types.StringType,
+	}
+)
+
+func NewDavinciVariableWithValueOptionsResource() resource.Resource {
+	return &davinciVariableWithValueOptionsResource{}
+}
+
+func (r *davinciVariableWithValueOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.davinciVariableResource.Schema(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Schema.Attributes["ignore_runtime_value_changes"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		Description:         "Whether changes made to the value of the variable outside of Terraform, such as by flows updating a mutable variable at runtime, are ignored.  When true, the variable value is only sent when the configured \"value\" changes.  Defaults to false.",
+		MarkdownDescription: "Whether changes made to the value of the variable outside of Terraform, such as by flows updating a mutable variable at runtime, are ignored.  When `true`, the variable value is only sent when the configured `value` changes.  Defaults to `false`.",
+	}
+
+	resp.Schema.Attributes["value_wo"] = schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Description:         "A write-only value for a variable with a \"data_type\" of \"secret\".  The value is not stored in state, so \"value_wo_version\" must be changed for an updated value to be applied.  Conflicts with \"value\".",
+		MarkdownDescription: "A write-only value for a variable with a `data_type` of `secret`.  The value is not stored in state, so `value_wo_version` must be changed for an updated value to be applied.  Conflicts with `value`.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.ConflictsWith(
+				path.MatchRoot("value"),
+			),
+		},
+	}
+
+	resp.Schema.Attributes["value_wo_version"] = schema.Int32Attribute{
+		Optional:            true,
+		Description:         "A version number for \"value_wo\".  Change this value to apply an updated \"value_wo\" to the variable.",
+		MarkdownDescription: "A version number for `value_wo`.  Change this value to apply an updated `value_wo` to the variable.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(
+				path.MatchRoot("value_wo"),
+			),
+		},
+	}
+}
+
+func (r *davinciVariableWithValueOptionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciVariableResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.validateConfig(ctx, &r.davinciVariableResource, req, resp)
+
+	var data davinciVariableWithValueOptionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ensure value_wo is only used for secret variables
+	if !data.ValueWo.IsNull() && !data.DataType.IsNull() && !data.DataType.IsUnknown() && data.DataType.ValueString() != "secret" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value_wo"),
+			"Invalid Data Type for Write-Only Value",
+			"The `value_wo` attribute can only be set when `data_type` is set to `secret`.",
+		)
+	}
 
 	// Ensure mutable is true if there is no value set
-	if data.Value.IsNull() && !data.Mutable.IsNull() && !data.Mutable.IsUnknown() && !data.Mutable.ValueBool() {
+	if data.Value.IsNull() && data.ValueWo.IsNull() && !data.Mutable.IsNull() && !data.Mutable.IsUnknown() && !data.Mutable.ValueBool() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("mutable"),
 			"Invalid Mutable Value",
@@ -112,3 +226,199 @@ func (r *davinciVariableResource) ValidateConfig(ctx context.Context, req resour
 		)
 	}
 }
+
+func (r *davinciVariableWithValueOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciVariableResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plannedValue types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("value"), &plannedValue)...)
+
+	// Write-only values are only available in the configuration
+	var valueWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &valueWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !valueWo.IsNull() && !valueWo.IsUnknown() {
+		resp.Diagnostics.Append(setDavinciVariablePlanWriteOnlyValue(ctx, &req.Plan, valueWo)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	extension.create(ctx, &r.davinciVariableResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The write-only value is not stored in state
+	if !valueWo.IsNull() && !valueWo.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), plannedValue)...)
+	}
+}
+
+func (r *davinciVariableWithValueOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciVariableResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.read(ctx, &r.davinciVariableResource, req, resp)
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+
+	var prior, data davinciVariableWithValueOptionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values set at runtime are not reflected in state when they are ignored
+	if data.IgnoreRuntimeValueChanges.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_runtime_value_changes"), types.BoolValue(false))...)
+	} else if data.IgnoreRuntimeValueChanges.ValueBool() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), prior.Value)...)
+	}
+}
+
+func (r *davinciVariableWithValueOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciVariableResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state davinciVariableWithValueOptionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Write-only values are only available in the configuration
+	var valueWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &valueWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !valueWo.IsNull() && !valueWo.IsUnknown() {
+		resp.Diagnostics.Append(setDavinciVariablePlanWriteOnlyValue(ctx, &req.Plan, valueWo)...)
+	} else if plan.IgnoreRuntimeValueChanges.ValueBool() && plan.Value.Equal(state.Value) {
+		// Keep the value set at runtime when the configured value has not changed
+		resp.Diagnostics.Append(r.setRuntimeValue(ctx, &plan.davinciVariableResourceModel, &req.Plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.update(ctx, &r.davinciVariableResource, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The write-only value is not stored in state, and values set at runtime are not reflected in state when they are
+	// ignored
+	if (!valueWo.IsNull() && !valueWo.IsUnknown()) || plan.IgnoreRuntimeValueChanges.ValueBool() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), plan.Value)...)
+	}
+}
+
+func (r *davinciVariableWithValueOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	extension, diags := newDavinciResourceExtension(ctx, &r.davinciVariableResource, r)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension.delete(ctx, &r.davinciVariableResource, req, resp)
+}
+
+// setDavinciVariablePlanWriteOnlyValue sets the planned value to the write-only value, so that it is sent by the
+// generated resource
+func setDavinciVariablePlanWriteOnlyValue(ctx context.Context, plan *tfsdk.Plan, valueWo types.String) diag.Diagnostics {
+	value, diags := types.ObjectValue(davinciVariableValueAttrTypes, map[string]attr.Value{
+		"bool":          types.BoolNull(),
+		"float32":       types.Float32Null(),
+		"json_object":   jsontypes.NewNormalizedNull(),
+		"string":        types.StringNull(),
+		"secret_string": valueWo,
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("value"), value)...)
+
+	return diags
+}
+
+// setRuntimeValue sets the planned value to the current value of the variable, so that a value set at runtime is not
+// overwritten by the update
+func (r *davinciVariableWithValueOptionsResource) setRuntimeValue(ctx context.Context, data *davinciVariableResourceModel, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.Client == nil {
+		diags.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return diags
+	}
+
+	environmentIdUuid, err := uuid.Parse(data.EnvironmentId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("environment_id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.EnvironmentId.ValueString(), "EnvironmentId", err.Error()),
+		)
+		return diags
+	}
+
+	idUuid, err := uuid.Parse(data.Id.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Attribute Validation Error",
+			fmt.Sprintf("The value '%s' for attribute '%s' is not a valid UUID: %s", data.Id.ValueString(), "Id", err.Error()),
+		)
+		return diags
+	}
+
+	var responseData *pingone.DaVinciVariableResponse
+	diags.Append(framework.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.DaVinciVariablesApi.GetVariableById(ctx, environmentIdUuid, idUuid).Execute()
+			return framework.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"GetVariableById",
+		framework.DefaultCustomError,
+		framework.DefaultCreateReadRetryable,
+		&responseData,
+	)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Secret values are returned obfuscated, so the planned value is left as is
+	if responseData.DataType == "secret" {
+		return diags
+	}
+
+	var runtime davinciVariableResourceModel
+	diags.Append(runtime.readClientResponse(responseData)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("value"), runtime.Value)...)
+
+	return diags
+}
@@ -0,0 +1,195 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package davinci_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/pingone-go-client/pingone"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+)
+
+func TestAccDavinciVariable_IgnoreRuntimeValueChanges(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_variable.%s", resourceName)
+
+	var environmentId string
+	var id string
+
+	var p1Client *pingone.APIClient
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+
+			p1Client = acctest.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciVariable_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: davinciVariable_IgnoreRuntimeValueChangesHCL(resourceName, "Seeded Variable"),
+				Check: resource.ComposeTestCheckFunc(
+					davinciVariable_GetIDs(resourceFullName, &environmentId, &id),
+					resource.TestCheckResourceAttr(resourceFullName, "ignore_runtime_value_changes", "true"),
+					resource.TestCheckResourceAttr(resourceFullName, "value.string", "seed"),
+				),
+			},
+			// Simulate a flow updating the variable value at runtime, which should not cause a plan
+			{
+				PreConfig: func() {
+					davinciVariable_SetRuntimeStringValue(ctx, p1Client, t, environmentId, id, resourceName, "runtime")
+				},
+				Config: davinciVariable_IgnoreRuntimeValueChangesHCL(resourceName, "Seeded Variable"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "value.string", "seed"),
+					davinciVariable_CheckRuntimeStringValue(ctx, &p1Client, &environmentId, &id, "runtime"),
+				),
+			},
+			// Updating other attributes should keep the runtime value
+			{
+				Config: davinciVariable_IgnoreRuntimeValueChangesHCL(resourceName, "Seeded Variable Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "display_name", "Seeded Variable Updated"),
+					resource.TestCheckResourceAttr(resourceFullName, "value.string", "seed"),
+					davinciVariable_CheckRuntimeStringValue(ctx, &p1Client, &environmentId, &id, "runtime"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDavinciVariable_ValueWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_davinci_variable.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             davinciVariable_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      davinciVariable_ValueWriteOnlyHCL(resourceName, "string", "secret-1", 1),
+				ExpectError: regexp.MustCompile("The `value_wo` attribute can only be set when `data_type` is set to `secret`."),
+			},
+			{
+				Config: davinciVariable_ValueWriteOnlyHCL(resourceName, "secret", "secret-1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceFullName, "value_wo"),
+					resource.TestCheckNoResourceAttr(resourceFullName, "value"),
+					resource.TestCheckResourceAttr(resourceFullName, "value_wo_version", "1"),
+				),
+			},
+			{
+				Config: davinciVariable_ValueWriteOnlyHCL(resourceName, "secret", "secret-2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceFullName, "value_wo"),
+					resource.TestCheckResourceAttr(resourceFullName, "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func davinciVariable_IgnoreRuntimeValueChangesHCL(resourceName, displayName string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_variable" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  context        = "company"
+  data_type      = "string"
+  mutable        = true
+  name           = "%[2]s"
+  display_name   = "%[3]s"
+  value = {
+    string = "seed"
+  }
+
+  ignore_runtime_value_changes = true
+}
+`, acctest.GenericSandboxEnvironment(), resourceName, displayName)
+}
+
+func davinciVariable_ValueWriteOnlyHCL(resourceName, dataType, value string, version int) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_davinci_variable" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  context        = "company"
+  data_type      = "%[3]s"
+  mutable        = false
+  name           = "%[2]s"
+
+  value_wo         = "%[4]s"
+  value_wo_version = %[5]d
+}
+`, acctest.GenericSandboxEnvironment(), resourceName, dataType, value, version)
+}
+
+// Set the value of the variable outside of Terraform, as a flow would at runtime
+func davinciVariable_SetRuntimeStringValue(ctx context.Context, apiClient *pingone.APIClient, t *testing.T, environmentId, id, name, value string) {
+	if environmentId == "" || id == "" {
+		t.Fatalf("One of the identifier attributes can't be determined. environmentId: '%s' id: '%s'", environmentId, id)
+	}
+
+	variable, _, err := apiClient.DaVinciVariablesApi.GetVariableById(ctx, uuid.MustParse(environmentId), uuid.MustParse(id)).Execute()
+	if err != nil {
+		t.Fatalf("Failed to read davinci_variable: %v", err)
+	}
+
+	request := pingone.DaVinciVariableReplaceRequest{
+		Name:        name,
+		Context:     pingone.DaVinciVariableReplaceRequestContext("company"),
+		DataType:    pingone.DaVinciVariableReplaceRequestDataType("string"),
+		Mutable:     true,
+		DisplayName: variable.DisplayName,
+		Value: &pingone.DaVinciVariableReplaceRequestValue{
+			String: &value,
+		},
+	}
+
+	_, _, err = apiClient.DaVinciVariablesApi.ReplaceVariableById(ctx, uuid.MustParse(environmentId), uuid.MustParse(id)).DaVinciVariableReplaceRequest(request).Execute()
+	if err != nil {
+		t.Fatalf("Failed to update davinci_variable value: %v", err)
+	}
+}
+
+func davinciVariable_CheckRuntimeStringValue(ctx context.Context, apiClient **pingone.APIClient, environmentId, id *string, expectedValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		variable, _, err := (*apiClient).DaVinciVariablesApi.GetVariableById(ctx, uuid.MustParse(*environmentId), uuid.MustParse(*id)).Execute()
+		if err != nil {
+			return fmt.Errorf("Failed to read davinci_variable: %v", err)
+		}
+
+		if variable.Value == nil || variable.Value.String == nil || *variable.Value.String != expectedValue {
+			return fmt.Errorf("Expected davinci_variable value to be %q, got %v", expectedValue, variable.Value)
+		}
+
+		return nil
+	}
+}
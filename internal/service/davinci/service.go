@@ -4,6 +4,7 @@ package davinci
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/pingidentity/pingone-go-client/pingone"
 )
@@ -14,15 +15,18 @@ type serviceClientType struct {
 
 func Resources() []func() resource.Resource {
 	resources := []func() resource.Resource{
-		NewDavinciApplicationFlowPolicyResource,
+		NewDavinciApplicationFlowPolicyWithTrafficSplitResource,
 		NewDavinciApplicationKeyResource,
 		NewDavinciApplicationResource,
 		NewDavinciApplicationSecretResource,
+		NewDavinciConnectorInstanceHttpResource,
+		NewDavinciConnectorInstancePingoneMfaResource,
+		NewDavinciConnectorInstancePingoneSsoResource,
 		NewDavinciConnectorInstanceResource,
 		NewDavinciFlowDeployResource,
 		NewDavinciFlowEnableResource,
-		NewDavinciFlowResource,
-		NewDavinciVariableResource,
+		NewDavinciFlowWithSubflowsResource,
+		NewDavinciVariableWithValueOptionsResource,
 	}
 	resources = append(resources, BetaResources()...)
 
@@ -37,8 +41,17 @@ func DataSources() []func() datasource.DataSource {
 		NewDavinciConnectorsDataSource,
 		NewDavinciConnectorInstanceDataSource,
 		NewDavinciConnectorInstancesDataSource,
+		NewDavinciFlowVersionsDataSource,
 	}
 	dataSources = append(dataSources, BetaDataSources()...)
 
 	return dataSources
 }
+
+func Functions() []func() function.Function {
+	return []func() function.Function{
+		NewDavinciFlowFromExportFunction,
+		NewDavinciFlowGraphDotFunction,
+		NewDavinciFlowGraphMermaidFunction,
+	}
+}
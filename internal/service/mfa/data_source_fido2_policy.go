@@ -0,0 +1,348 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/mfa"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type FIDO2PolicyDataSource serviceClientType
+
+type fido2PolicyDataSourceModel struct {
+	Id                            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId                 pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	FIDO2PolicyId                 pingonetypes.ResourceIDValue `tfsdk:"fido2_policy_id"`
+	Name                          types.String                 `tfsdk:"name"`
+	Description                   types.String                 `tfsdk:"description"`
+	Default                       types.Bool                   `tfsdk:"default"`
+	AttestationRequirements       types.String                 `tfsdk:"attestation_requirements"`
+	AuthenticatorAttachment       types.String                 `tfsdk:"authenticator_attachment"`
+	BackupEligibility             types.Object                 `tfsdk:"backup_eligibility"`
+	DeviceDisplayName             types.String                 `tfsdk:"device_display_name"`
+	DiscoverableCredentials       types.String                 `tfsdk:"discoverable_credentials"`
+	MdsAuthenticatorsRequirements types.Object                 `tfsdk:"mds_authenticators_requirements"`
+	RelyingPartyId                types.String                 `tfsdk:"relying_party_id"`
+	UserDisplayNameAttributes     types.Object                 `tfsdk:"user_display_name_attributes"`
+	UserPresenceTimeout           types.Object                 `tfsdk:"user_presence_timeout"`
+	UserVerification              types.Object                 `tfsdk:"user_verification"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &FIDO2PolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &FIDO2PolicyDataSource{}
+)
+
+// New Object
+func NewFIDO2PolicyDataSource() datasource.DataSource {
+	return &FIDO2PolicyDataSource{}
+}
+
+// Metadata
+func (r *FIDO2PolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mfa_fido2_policy"
+}
+
+// Schema
+func (r *FIDO2PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"fido2_policy_id",
+		"name",
+		"default",
+	}
+
+	fido2PolicyIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the FIDO2 policy to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the FIDO2 policy to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	defaultDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"Set value to `true` to return the default FIDO2 policy. There is only one default policy per environment.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned policy attributes are taken from the pingone_mfa_fido2_policy resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&FIDO2PolicyResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the FIDO2 policy from."),
+	)
+
+	attributes["fido2_policy_id"] = schema.StringAttribute{
+		Description:         fido2PolicyIdDescription.Description,
+		MarkdownDescription: fido2PolicyIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("fido2_policy_id"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	attributes["default"] = schema.BoolAttribute{
+		Description:         defaultDescription.Description,
+		MarkdownDescription: defaultDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.Bool{
+			boolvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("fido2_policy_id"),
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+			boolvalidator.Equals(true),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve the default PingOne FIDO2 policy or to find a PingOne FIDO2 policy by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *FIDO2PolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *FIDO2PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *fido2PolicyDataSourceModel
+
+	if r.Client == nil || r.Client.MFAAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fido2Policy *mfa.FIDO2Policy
+
+	if !data.FIDO2PolicyId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.MFAAPIClient.FIDO2PolicyApi.ReadOneFIDO2Policy(ctx, data.EnvironmentId.ValueString(), data.FIDO2PolicyId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneFIDO2Policy",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&fido2Policy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return r.findFIDO2Policy(ctx, data.EnvironmentId.ValueString(), func(policy mfa.FIDO2Policy) bool {
+					return strings.EqualFold(policy.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadFIDO2Policies",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&fido2Policy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if fido2Policy == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find FIDO2 policy from name",
+				fmt.Sprintf("The FIDO2 policy name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else if data.Default.ValueBool() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return r.findFIDO2Policy(ctx, data.EnvironmentId.ValueString(), func(policy mfa.FIDO2Policy) bool {
+					return policy.GetDefault()
+				})
+			},
+			"ReadFIDO2Policies",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&fido2Policy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if fido2Policy == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find default FIDO2 policy",
+				fmt.Sprintf("The default FIDO2 policy for environment %s cannot be found", data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested PingOne FIDO2 policy: fido2_policy_id, name, or default argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(fido2Policy)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *fido2PolicyDataSourceModel) toState(apiObject *mfa.FIDO2Policy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &FIDO2PolicyResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.EnvironmentId = resourceModel.EnvironmentId
+	p.FIDO2PolicyId = resourceModel.Id
+	p.Name = resourceModel.Name
+	p.Description = resourceModel.Description
+	p.Default = resourceModel.Default
+	p.AttestationRequirements = resourceModel.AttestationRequirements
+	p.AuthenticatorAttachment = resourceModel.AuthenticatorAttachment
+	p.BackupEligibility = resourceModel.BackupEligibility
+	p.DeviceDisplayName = resourceModel.DeviceDisplayName
+	p.DiscoverableCredentials = resourceModel.DiscoverableCredentials
+	p.MdsAuthenticatorsRequirements = resourceModel.MdsAuthenticatorsRequirements
+	p.RelyingPartyId = resourceModel.RelyingPartyId
+	p.UserDisplayNameAttributes = resourceModel.UserDisplayNameAttributes
+	p.UserPresenceTimeout = resourceModel.UserPresenceTimeout
+	p.UserVerification = resourceModel.UserVerification
+
+	return diags
+}
+
+func (r *FIDO2PolicyDataSource) findFIDO2Policy(ctx context.Context, environmentID string, matcher func(mfa.FIDO2Policy) bool) (*mfa.FIDO2Policy, *http.Response, error) {
+	pagedIterator := r.Client.MFAAPIClient.FIDO2PolicyApi.ReadFIDO2Policies(ctx, environmentID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if policies, ok := pageCursor.EntityArray.Embedded.GetFido2PoliciesOk(); ok {
+			for _, policy := range policies {
+				if matcher(policy) {
+					return &policy, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
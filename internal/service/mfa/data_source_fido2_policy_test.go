@@ -0,0 +1,276 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/mfa"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccFIDO2PolicyDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_fido2_policy.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_mfa_fido2_policy.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.FIDO2Policy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFIDO2PolicyDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "fido2_policy_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "description", resourceFullName, "description"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "attestation_requirements", "NONE"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "authenticator_attachment", "PLATFORM"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "backup_eligibility.allow", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "backup_eligibility.enforce_during_authentication", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "device_display_name", "fidoPolicy.deviceDisplayName02"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "discoverable_credentials", "DISCOURAGED"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "mds_authenticators_requirements.option", "NONE"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "relying_party_id", "ping-devops.com"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "user_display_name_attributes.attributes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "user_display_name_attributes.attributes.0.name", "username"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "user_presence_timeout.duration", resourceFullName, "user_presence_timeout.duration"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "user_verification.option", "DISCOURAGED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFIDO2PolicyDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_fido2_policy.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_mfa_fido2_policy.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.FIDO2Policy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFIDO2PolicyDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "fido2_policy_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "relying_party_id", "ping-devops.com"),
+				),
+			},
+			{
+				Config: testAccFIDO2PolicyDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFIDO2PolicyDataSource_ByDefault(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_mfa_fido2_policy.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.FIDO2Policy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFIDO2PolicyDataSourceConfig_ByDefault(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "fido2_policy_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFIDO2PolicyDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.FIDO2Policy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFIDO2PolicyDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find FIDO2 policy from name"),
+			},
+			{
+				Config:      testAccFIDO2PolicyDataSourceConfig_DefaultFalse(resourceName),
+				ExpectError: regexp.MustCompile(`Attribute default Value must be "true", got: false`),
+			},
+			{
+				Config:      testAccFIDO2PolicyDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneFIDO2Policy`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccFIDO2PolicyDataSourceConfig_Policy(resourceName, name string) string {
+	return fmt.Sprintf(`
+resource "pingone_mfa_fido2_policy" "%[1]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+  description    = "%[2]s"
+
+  attestation_requirements = "NONE"
+  authenticator_attachment = "PLATFORM"
+
+  backup_eligibility = {
+    allow                         = false
+    enforce_during_authentication = true
+  }
+
+  device_display_name = "fidoPolicy.deviceDisplayName02"
+
+  discoverable_credentials = "DISCOURAGED"
+
+  mds_authenticators_requirements = {
+    enforce_during_authentication = false
+    option                        = "NONE"
+  }
+
+  relying_party_id = "ping-devops.com"
+
+  user_display_name_attributes = {
+    attributes = [
+      {
+        name = "username"
+      }
+    ]
+  }
+
+  user_verification = {
+    enforce_during_authentication = false
+    option                        = "DISCOURAGED"
+  }
+
+}`, resourceName, name)
+}
+
+func testAccFIDO2PolicyDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_mfa_fido2_policy" "%[3]s" {
+  environment_id  = data.pingone_environment.general_test.id
+  fido2_policy_id = pingone_mfa_fido2_policy.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccFIDO2PolicyDataSourceConfig_Policy(resourceName, name), resourceName)
+}
+
+func testAccFIDO2PolicyDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_mfa_fido2_policy" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_mfa_fido2_policy.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccFIDO2PolicyDataSourceConfig_Policy(resourceName, name), resourceName, nameComparator)
+}
+
+func testAccFIDO2PolicyDataSourceConfig_ByDefault(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_fido2_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  default        = true
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccFIDO2PolicyDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_fido2_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccFIDO2PolicyDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_fido2_policy" "%[2]s" {
+  environment_id  = data.pingone_environment.general_test.id
+  fido2_policy_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccFIDO2PolicyDataSourceConfig_DefaultFalse(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_fido2_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  default        = false
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -0,0 +1,342 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/mfa"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type MFADevicePolicyDataSource serviceClientType
+
+type mfaDevicePolicyDataSourceModel struct {
+	Id                    pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId         pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	MFADevicePolicyId     pingonetypes.ResourceIDValue `tfsdk:"mfa_device_policy_id"`
+	PolicyType            types.String                 `tfsdk:"policy_type"`
+	Name                  types.String                 `tfsdk:"name"`
+	Authentication        types.Object                 `tfsdk:"authentication"`
+	NewDeviceNotification types.String                 `tfsdk:"new_device_notification"`
+	IgnoreUserLock        types.Bool                   `tfsdk:"ignore_user_lock"`
+	NotificationsPolicy   types.Object                 `tfsdk:"notifications_policy"`
+	RememberMe            types.Object                 `tfsdk:"remember_me"`
+	Default               types.Bool                   `tfsdk:"default"`
+	Sms                   types.Object                 `tfsdk:"sms"`
+	Voice                 types.Object                 `tfsdk:"voice"`
+	Email                 types.Object                 `tfsdk:"email"`
+	WhatsApp              types.Object                 `tfsdk:"whats_app"`
+	Mobile                types.Object                 `tfsdk:"mobile"`
+	Totp                  types.Object                 `tfsdk:"totp"`
+	Fido2                 types.Object                 `tfsdk:"fido2"`
+	Desktop               types.Object                 `tfsdk:"desktop"`
+	Yubikey               types.Object                 `tfsdk:"yubikey"`
+	OathToken             types.Object                 `tfsdk:"oath_token"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &MFADevicePolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &MFADevicePolicyDataSource{}
+)
+
+// New Object
+func NewMFADevicePolicyDataSource() datasource.DataSource {
+	return &MFADevicePolicyDataSource{}
+}
+
+// Metadata
+func (r *MFADevicePolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mfa_device_policy"
+}
+
+// Schema
+func (r *MFADevicePolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"mfa_device_policy_id",
+		"name",
+		"default",
+	}
+
+	mfaDevicePolicyIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the MFA device policy to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the MFA device policy to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	defaultDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"Set value to `true` to return the default MFA device policy. There is only one default policy per environment.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned policy attributes are taken from the pingone_mfa_device_policy resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&MFADevicePolicyResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the MFA device policy from."),
+	)
+
+	attributes["mfa_device_policy_id"] = schema.StringAttribute{
+		Description:         mfaDevicePolicyIdDescription.Description,
+		MarkdownDescription: mfaDevicePolicyIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("mfa_device_policy_id"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	attributes["default"] = schema.BoolAttribute{
+		Description:         defaultDescription.Description,
+		MarkdownDescription: defaultDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.Bool{
+			boolvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("mfa_device_policy_id"),
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+			boolvalidator.Equals(true),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve the default PingOne MFA device policy or to find a PingOne MFA device policy by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *MFADevicePolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *MFADevicePolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *mfaDevicePolicyDataSourceModel
+
+	if r.Client == nil || r.Client.MFAAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mfaDevicePolicy *mfa.DeviceAuthenticationPolicy
+
+	if !data.MFADevicePolicyId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.MFAAPIClient.DeviceAuthenticationPolicyApi.ReadOneDeviceAuthenticationPolicy(ctx, data.EnvironmentId.ValueString(), data.MFADevicePolicyId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneDeviceAuthenticationPolicy",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&mfaDevicePolicy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				pagedIterator := r.Client.MFAAPIClient.DeviceAuthenticationPolicyApi.ReadDeviceAuthenticationPolicies(ctx, data.EnvironmentId.ValueString()).Execute()
+
+				var initialHttpResponse *http.Response
+
+				for pageCursor, err := range pagedIterator {
+					if err != nil {
+						return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+					}
+
+					if initialHttpResponse == nil {
+						initialHttpResponse = pageCursor.HTTPResponse
+					}
+
+					if policies, ok := pageCursor.EntityArray.Embedded.GetDeviceAuthenticationPoliciesOk(); ok {
+
+						for _, policyItem := range policies {
+
+							if strings.EqualFold(policyItem.GetName(), data.Name.ValueString()) {
+								return &policyItem, pageCursor.HTTPResponse, nil
+							}
+						}
+					}
+				}
+
+				return nil, initialHttpResponse, nil
+			},
+			"ReadDeviceAuthenticationPolicies",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&mfaDevicePolicy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if mfaDevicePolicy == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find MFA device policy from name",
+				fmt.Sprintf("The MFA device policy name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else if data.Default.ValueBool() {
+		var d diag.Diagnostics
+		mfaDevicePolicy, d = FetchDefaultMFADevicePolicy(ctx, r.Client.MFAAPIClient, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), false)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if mfaDevicePolicy == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find default MFA device policy",
+				fmt.Sprintf("The default MFA device policy for environment %s cannot be found", data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested PingOne MFA device policy: mfa_device_policy_id, name, or default argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(mfaDevicePolicy)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *mfaDevicePolicyDataSourceModel) toState(apiObject *mfa.DeviceAuthenticationPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &MFADevicePolicyResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.EnvironmentId = resourceModel.EnvironmentId
+	p.MFADevicePolicyId = resourceModel.Id
+	p.PolicyType = resourceModel.PolicyType
+	p.Name = resourceModel.Name
+	p.Authentication = resourceModel.Authentication
+	p.NewDeviceNotification = resourceModel.NewDeviceNotification
+	p.IgnoreUserLock = resourceModel.IgnoreUserLock
+	p.NotificationsPolicy = resourceModel.NotificationsPolicy
+	p.RememberMe = resourceModel.RememberMe
+	p.Default = resourceModel.Default
+	p.Sms = resourceModel.Sms
+	p.Voice = resourceModel.Voice
+	p.Email = resourceModel.Email
+	p.WhatsApp = resourceModel.WhatsApp
+	p.Mobile = resourceModel.Mobile
+	p.Totp = resourceModel.Totp
+	p.Fido2 = resourceModel.Fido2
+	p.Desktop = resourceModel.Desktop
+	p.Yubikey = resourceModel.Yubikey
+	p.OathToken = resourceModel.OathToken
+
+	return diags
+}
@@ -0,0 +1,262 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/mfa"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccMFADevicePolicyDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device_policy.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_mfa_device_policy.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevicePolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADevicePolicyDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "mfa_device_policy_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "policy_type", resourceFullName, "policy_type"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "sms.enabled", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "sms.otp.failure.count", resourceFullName, "sms.otp.failure.count"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "voice.enabled", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "email.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "mobile.enabled", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "totp.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "fido2.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMFADevicePolicyDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device_policy.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_mfa_device_policy.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevicePolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADevicePolicyDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "mfa_device_policy_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "sms.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccMFADevicePolicyDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMFADevicePolicyDataSource_ByDefault(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_mfa_device_policy.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevicePolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADevicePolicyDataSourceConfig_ByDefault(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "mfa_device_policy_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMFADevicePolicyDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevicePolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMFADevicePolicyDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find MFA device policy from name"),
+			},
+			{
+				Config:      testAccMFADevicePolicyDataSourceConfig_DefaultFalse(resourceName),
+				ExpectError: regexp.MustCompile(`Attribute default Value must be "true", got: false`),
+			},
+			{
+				Config:      testAccMFADevicePolicyDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneDeviceAuthenticationPolicy`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccMFADevicePolicyDataSourceConfig_Policy(resourceName, name string) string {
+	return fmt.Sprintf(`
+resource "pingone_mfa_device_policy" "%[1]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[2]s"
+
+  sms = {
+    enabled = true
+  }
+
+  voice = {
+    enabled = false
+  }
+
+  email = {
+    enabled = true
+  }
+
+  mobile = {
+    enabled = false
+  }
+
+  totp = {
+    enabled = true
+  }
+
+  fido2 = {
+    enabled = true
+  }
+
+}`, resourceName, name)
+}
+
+func testAccMFADevicePolicyDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_mfa_device_policy" "%[3]s" {
+  environment_id       = data.pingone_environment.general_test.id
+  mfa_device_policy_id = pingone_mfa_device_policy.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccMFADevicePolicyDataSourceConfig_Policy(resourceName, name), resourceName)
+}
+
+func testAccMFADevicePolicyDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_mfa_device_policy" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_mfa_device_policy.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccMFADevicePolicyDataSourceConfig_Policy(resourceName, name), resourceName, nameComparator)
+}
+
+func testAccMFADevicePolicyDataSourceConfig_ByDefault(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_device_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  default        = true
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccMFADevicePolicyDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_device_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccMFADevicePolicyDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_device_policy" "%[2]s" {
+  environment_id       = data.pingone_environment.general_test.id
+  mfa_device_policy_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccMFADevicePolicyDataSourceConfig_DefaultFalse(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_mfa_device_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  default        = false
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -0,0 +1,283 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type UserMFADevicesDataSource serviceClientType
+
+type UserMFADevicesDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	UserId        pingonetypes.ResourceIDValue `tfsdk:"user_id"`
+	Ids           types.List                   `tfsdk:"ids"`
+	Devices       types.List                   `tfsdk:"devices"`
+}
+
+var (
+	UserMFADevicesDeviceTFObjectTypes = map[string]attr.Type{
+		"id":                   pingonetypes.ResourceIDType{},
+		"type":                 types.StringType,
+		"status":               types.StringType,
+		"nickname":             types.StringType,
+		"mfa_device_policy_id": pingonetypes.ResourceIDType{},
+		"email":                types.StringType,
+		"phone":                types.StringType,
+		"extension":            types.StringType,
+		"created_at":           timetypes.RFC3339Type{},
+		"updated_at":           timetypes.RFC3339Type{},
+	}
+)
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &UserMFADevicesDataSource{}
+)
+
+// New Object
+func NewUserMFADevicesDataSource() datasource.DataSource {
+	return &UserMFADevicesDataSource{}
+}
+
+// Metadata
+func (r *UserMFADevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_mfa_devices"
+}
+
+// Schema
+func (r *UserMFADevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	devicesDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of the MFA devices enrolled for the user, in the user's device order.",
+	)
+
+	deviceTypeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The type of the MFA device.",
+	).AllowedValues(mfaDeviceTypeEmail, mfaDeviceTypeFIDO2, mfaDeviceTypeSMS, mfaDeviceTypeTOTP, mfaDeviceTypeVoice)
+
+	deviceStatusDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The status of the MFA device.",
+	).AllowedValues(mfaDeviceStatusActive, mfaDeviceStatusActivationRequired)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve the MFA devices enrolled for a user in a PingOne environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that contains the user."),
+			),
+
+			"user_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the user to retrieve MFA devices for."),
+			),
+
+			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
+				"The list of resulting IDs of MFA devices that have been successfully retrieved.",
+			)),
+
+			"devices": schema.ListNestedAttribute{
+				Description:         devicesDescription.Description,
+				MarkdownDescription: devicesDescription.MarkdownDescription,
+				Computed:            true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the MFA device.").Description,
+							Computed:    true,
+
+							CustomType: pingonetypes.ResourceIDType{},
+						},
+
+						"type": schema.StringAttribute{
+							Description:         deviceTypeDescription.Description,
+							MarkdownDescription: deviceTypeDescription.MarkdownDescription,
+							Computed:            true,
+						},
+
+						"status": schema.StringAttribute{
+							Description:         deviceStatusDescription.Description,
+							MarkdownDescription: deviceStatusDescription.MarkdownDescription,
+							Computed:            true,
+						},
+
+						"nickname": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The user friendly name of the MFA device.").Description,
+							Computed:    true,
+						},
+
+						"mfa_device_policy_id": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the MFA device policy that the device was created under.").Description,
+							Computed:    true,
+
+							CustomType: pingonetypes.ResourceIDType{},
+						},
+
+						"email": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The email address of an email MFA device.").Description,
+							Computed:    true,
+						},
+
+						"phone": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The phone number of an SMS or voice MFA device.").Description,
+							Computed:    true,
+						},
+
+						"extension": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The phone extension of a voice MFA device.").Description,
+							Computed:    true,
+						},
+
+						"created_at": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The time the MFA device was created.").Description,
+							Computed:    true,
+
+							CustomType: timetypes.RFC3339Type{},
+						},
+
+						"updated_at": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The time the MFA device was last updated.").Description,
+							Computed:    true,
+
+							CustomType: timetypes.RFC3339Type{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *UserMFADevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *UserMFADevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UserMFADevicesDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var devices []mfaDevice
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readAllMFADevices(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.UserId.ValueString())
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadAllMFAUserDevices",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&devices,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(devices)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *UserMFADevicesDataSourceModel) toState(apiObject []mfaDevice) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = p.UserId
+
+	ids := make([]string, 0, len(apiObject))
+	devices := make([]attr.Value, 0, len(apiObject))
+
+	for _, device := range apiObject {
+		ids = append(ids, device.Id)
+
+		policyID := pingonetypes.NewResourceIDNull()
+		if device.Policy != nil {
+			policyID = framework.PingOneResourceIDToTF(device.Policy.Id)
+		}
+
+		deviceObj, d := types.ObjectValue(UserMFADevicesDeviceTFObjectTypes, map[string]attr.Value{
+			"id":                   framework.PingOneResourceIDToTF(device.Id),
+			"type":                 framework.StringToTF(device.Type),
+			"status":               framework.StringToTF(device.Status),
+			"nickname":             framework.StringOkToTF(device.Nickname, device.Nickname != nil),
+			"mfa_device_policy_id": policyID,
+			"email":                framework.StringOkToTF(device.Email, device.Email != nil),
+			"phone":                framework.StringOkToTF(device.Phone, device.Phone != nil),
+			"extension":            framework.StringOkToTF(device.Extension, device.Extension != nil),
+			"created_at":           framework.TimeOkToTF(device.CreatedAt, device.CreatedAt != nil),
+			"updated_at":           framework.TimeOkToTF(device.UpdatedAt, device.UpdatedAt != nil),
+		})
+		diags.Append(d...)
+
+		devices = append(devices, deviceObj)
+	}
+
+	p.Ids, d = framework.StringSliceToTF(ids)
+	diags.Append(d...)
+
+	p.Devices, d = types.ListValue(types.ObjectType{AttrTypes: UserMFADevicesDeviceTFObjectTypes}, devices)
+	diags.Append(d...)
+
+	return diags
+}
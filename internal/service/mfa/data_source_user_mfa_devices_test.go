@@ -0,0 +1,161 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/mfa"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccUserMFADevicesDataSource_ByUser(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_user_mfa_devices.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserMFADevicesDataSourceConfig_ByUser(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", fmt.Sprintf("pingone_user.%s", resourceName), "id"),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "user_id", fmt.Sprintf("pingone_user.%s", resourceName), "id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "devices.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "devices.*", map[string]string{
+						"type":     "EMAIL",
+						"status":   "ACTIVE",
+						"nickname": "Work email",
+						"email":    fmt.Sprintf("%s@pingidentity.com", name),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "devices.*", map[string]string{
+						"type":   "SMS",
+						"status": "ACTIVE",
+						"phone":  "+14155552671",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "devices.*.mfa_device_policy_id", fmt.Sprintf("pingone_mfa_device_policy.%s", resourceName), "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserMFADevicesDataSource_NoDevices(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_user_mfa_devices.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserMFADevicesDataSourceConfig_NoDevices(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "devices.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserMFADevicesDataSourceConfig_ByUser(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+
+  sms = {
+    enabled = true
+  }
+
+  voice = {
+    enabled = true
+  }
+
+  email = {
+    enabled = true
+  }
+
+  mobile = {
+    enabled = true
+  }
+
+  totp = {
+    enabled = true
+  }
+
+  fido2 = {
+    enabled = true
+  }
+}
+
+resource "pingone_mfa_device" "%[2]s-email" {
+  environment_id       = data.pingone_environment.general_test.id
+  user_id              = pingone_user.%[2]s.id
+  mfa_device_policy_id = pingone_mfa_device_policy.%[2]s.id
+
+  nickname = "Work email"
+
+  email = {
+    email = "%[3]s@pingidentity.com"
+  }
+}
+
+resource "pingone_mfa_device" "%[2]s-sms" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  sms = {
+    phone = "+14155552671"
+  }
+}
+
+data "pingone_user_mfa_devices" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  depends_on = [
+    pingone_mfa_device.%[2]s-email,
+    pingone_mfa_device.%[2]s-sms,
+  ]
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName, name)
+}
+
+func testAccUserMFADevicesDataSourceConfig_NoDevices(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+data "pingone_user_mfa_devices" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName)
+}
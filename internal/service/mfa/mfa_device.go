@@ -0,0 +1,159 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+// User MFA devices are not modelled by the MFA SDK, so the platform API is called directly with these types.
+
+const (
+	mfaDeviceTypeEmail = "EMAIL"
+	mfaDeviceTypeFIDO2 = "FIDO2"
+	mfaDeviceTypeSMS   = "SMS"
+	mfaDeviceTypeTOTP  = "TOTP"
+	mfaDeviceTypeVoice = "VOICE"
+
+	mfaDeviceStatusActive             = "ACTIVE"
+	mfaDeviceStatusActivationRequired = "ACTIVATION_REQUIRED"
+
+	mfaDeviceActivateContentType = "application/vnd.pingidentity.device.activate+json"
+
+	// PingOne TOTP devices use RFC 6238 defaults
+	mfaDeviceTOTPDigits = 6
+	mfaDeviceTOTPPeriod = 30 * time.Second
+)
+
+type mfaDevice struct {
+	Id        string                   `json:"id,omitempty"`
+	Type      string                   `json:"type"`
+	Status    string                   `json:"status,omitempty"`
+	Nickname  *string                  `json:"nickname,omitempty"`
+	Phone     *string                  `json:"phone,omitempty"`
+	Extension *string                  `json:"extension,omitempty"`
+	Email     *string                  `json:"email,omitempty"`
+	Policy    *mfaDeviceResourceObject `json:"policy,omitempty"`
+	Secret    *string                  `json:"secret,omitempty"`
+	KeyUri    *string                  `json:"keyUri,omitempty"`
+	CreatedAt *time.Time               `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time               `json:"updatedAt,omitempty"`
+}
+
+type mfaDeviceResourceObject struct {
+	Id string `json:"id"`
+}
+
+type mfaDeviceCollection struct {
+	Embedded struct {
+		Devices []mfaDevice `json:"devices"`
+	} `json:"_embedded"`
+}
+
+func mfaDevicesPath(environmentID, userID string) string {
+	return fmt.Sprintf("/environments/%s/users/%s/devices", url.PathEscape(environmentID), url.PathEscape(userID))
+}
+
+func mfaDevicePath(environmentID, userID, deviceID string) string {
+	return fmt.Sprintf("%s/%s", mfaDevicesPath(environmentID, userID), url.PathEscape(deviceID))
+}
+
+func createMFADevice(ctx context.Context, apiClient *management.APIClient, environmentID, userID string, device mfaDevice) (any, *http.Response, error) {
+	var response mfaDevice
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPost, mfaDevicesPath(environmentID, userID), "", device, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+// readMFADevice returns a nil device, without error, when the device cannot be found
+func readMFADevice(ctx context.Context, apiClient *management.APIClient, environmentID, userID, deviceID string) (any, *http.Response, error) {
+	var response mfaDevice
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, mfaDevicePath(environmentID, userID, deviceID), "", nil, &response)
+	if fR != nil && fR.StatusCode == http.StatusNotFound {
+		return nil, nil, nil
+	}
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func readAllMFADevices(ctx context.Context, apiClient *management.APIClient, environmentID, userID string) (any, *http.Response, error) {
+	var response mfaDeviceCollection
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodGet, mfaDevicesPath(environmentID, userID), "", nil, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	devices := response.Embedded.Devices
+	if devices == nil {
+		devices = make([]mfaDevice, 0)
+	}
+
+	return devices, fR, nil
+}
+
+func activateMFADevice(ctx context.Context, apiClient *management.APIClient, environmentID, userID, deviceID, otp string) (any, *http.Response, error) {
+	var response mfaDevice
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPost, mfaDevicePath(environmentID, userID, deviceID), mfaDeviceActivateContentType, map[string]string{"otp": otp}, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func updateMFADeviceNickname(ctx context.Context, apiClient *management.APIClient, environmentID, userID, deviceID, nickname string) (any, *http.Response, error) {
+	var response mfaDevice
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPut, mfaDevicePath(environmentID, userID, deviceID)+"/nickname", "", map[string]string{"nickname": nickname}, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func deleteMFADevice(ctx context.Context, apiClient *management.APIClient, environmentID, userID, deviceID string) (*http.Response, error) {
+	return legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodDelete, mfaDevicePath(environmentID, userID, deviceID), "", nil, nil)
+}
+
+// generateTOTP returns the time-based one-time passcode for a base32 encoded secret at the given time, per RFC 6238 with SHA-1
+func generateTOTP(secret string, t time.Time) (string, error) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(strings.ToUpper(strings.ReplaceAll(secret, " ", "")), "="))
+	if err != nil {
+		return "", fmt.Errorf("cannot decode TOTP secret: %w", err)
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(t.Unix()/int64(mfaDeviceTOTPPeriod.Seconds())))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulus := uint32(1)
+	for range mfaDeviceTOTPDigits {
+		modulus *= 10
+	}
+
+	return fmt.Sprintf("%0*d", mfaDeviceTOTPDigits, code%modulus), nil
+}
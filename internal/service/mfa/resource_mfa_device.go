@@ -0,0 +1,888 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// Types
+type MFADeviceResource serviceClientType
+
+type MFADeviceResourceModel struct {
+	Id                pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId     pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	UserId            pingonetypes.ResourceIDValue `tfsdk:"user_id"`
+	MFADevicePolicyId pingonetypes.ResourceIDValue `tfsdk:"mfa_device_policy_id"`
+	Type              types.String                 `tfsdk:"type"`
+	Status            types.String                 `tfsdk:"status"`
+	Nickname          types.String                 `tfsdk:"nickname"`
+	Email             types.Object                 `tfsdk:"email"`
+	Sms               types.Object                 `tfsdk:"sms"`
+	Voice             types.Object                 `tfsdk:"voice"`
+	Totp              types.Object                 `tfsdk:"totp"`
+}
+
+type MFADeviceEmailResourceModel struct {
+	Email types.String `tfsdk:"email"`
+}
+
+type MFADeviceSmsResourceModel struct {
+	Phone types.String `tfsdk:"phone"`
+}
+
+type MFADeviceVoiceResourceModel struct {
+	Phone     types.String `tfsdk:"phone"`
+	Extension types.String `tfsdk:"extension"`
+}
+
+type MFADeviceTotpResourceModel struct {
+	Secret types.String `tfsdk:"secret"`
+	KeyUri types.String `tfsdk:"key_uri"`
+}
+
+var (
+	MFADeviceEmailTFObjectTypes = map[string]attr.Type{
+		"email": types.StringType,
+	}
+
+	MFADeviceSmsTFObjectTypes = map[string]attr.Type{
+		"phone": types.StringType,
+	}
+
+	MFADeviceVoiceTFObjectTypes = map[string]attr.Type{
+		"phone":     types.StringType,
+		"extension": types.StringType,
+	}
+
+	MFADeviceTotpTFObjectTypes = map[string]attr.Type{
+		"secret":  types.StringType,
+		"key_uri": types.StringType,
+	}
+)
+
+// Framework interfaces
+var (
+	_ resource.Resource                = &MFADeviceResource{}
+	_ resource.ResourceWithConfigure   = &MFADeviceResource{}
+	_ resource.ResourceWithImportState = &MFADeviceResource{}
+)
+
+// New Object
+func NewMFADeviceResource() resource.Resource {
+	return &MFADeviceResource{}
+}
+
+// Metadata
+func (r *MFADeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mfa_device"
+}
+
+// Schema.
+func (r *MFADeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	mfaDevicePolicyIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the MFA device policy that the device is created under, such as one managed with the `pingone_mfa_device_policy` resource.  If not set, the environment's default MFA device policy applies, and the ID of the policy returned by the service is stored.  Must be a valid PingOne resource ID.",
+	).RequiresReplace()
+
+	typeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The type of the MFA device, determined by which of the device type attributes is configured.",
+	).AllowedValues(mfaDeviceTypeEmail, mfaDeviceTypeSMS, mfaDeviceTypeTOTP, mfaDeviceTypeVoice)
+
+	statusDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The status of the MFA device when it is created.  When set to `ACTIVE`, email, SMS and voice devices are created in an active state, and TOTP devices are activated by the provider using a passcode generated from the device secret.  When set to `ACTIVATION_REQUIRED`, the user must activate the device before it can be used.  The status is only applied when the device is created: a device that the user has since activated continues to satisfy a status of `ACTIVATION_REQUIRED`, and the provider cannot change the status of an existing device to `ACTIVE`.",
+	).AllowedValuesComplex(map[string]string{
+		mfaDeviceStatusActive:             "the device is active and can be used for authentication",
+		mfaDeviceStatusActivationRequired: "the device must be activated by the user before it can be used for authentication",
+	}).DefaultValue(mfaDeviceStatusActive)
+
+	nicknameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies a user friendly name for the device, shown to the user during authentication.",
+	)
+
+	emailDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A single object that specifies the settings for an email MFA device.",
+	).ExactlyOneOf([]string{"email", "sms", "totp", "voice"}).RequiresReplace()
+
+	smsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A single object that specifies the settings for an SMS MFA device.",
+	).ExactlyOneOf([]string{"email", "sms", "totp", "voice"}).RequiresReplace()
+
+	voiceDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A single object that specifies the settings for a voice MFA device.",
+	).ExactlyOneOf([]string{"email", "sms", "totp", "voice"}).RequiresReplace()
+
+	totpDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A single object that specifies the settings for a TOTP (authenticator app) MFA device.  Configure as an empty object (`totp = {}`) to create a TOTP device.",
+	).ExactlyOneOf([]string{"email", "sms", "totp", "voice"}).RequiresReplace()
+
+	totpSecretDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The base32 encoded secret of the TOTP device, used to generate passcodes in an authenticator app or in automated tests.  The secret is only returned by the service when the device is created, and is null when the device is imported.",
+	)
+
+	totpKeyUriDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The `otpauth://` key URI of the TOTP device, that can be rendered as a QR code to register the device in an authenticator app.  The key URI is only returned by the service when the device is created, and is null when the device is imported.",
+	)
+
+	deviceTypeValidator := objectvalidator.ExactlyOneOf(
+		path.MatchRelative().AtParent().AtName("email"),
+		path.MatchRelative().AtParent().AtName("sms"),
+		path.MatchRelative().AtParent().AtName("totp"),
+		path.MatchRelative().AtParent().AtName("voice"),
+	)
+
+	resp.Schema = schema.Schema{
+
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to create and manage an MFA device for a user in a PingOne environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to create the MFA device in."),
+			),
+
+			"user_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the user to create the MFA device for."),
+			),
+
+			"mfa_device_policy_id": schema.StringAttribute{
+				Description:         mfaDevicePolicyIdDescription.Description,
+				MarkdownDescription: mfaDevicePolicyIdDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"type": schema.StringAttribute{
+				Description:         typeDescription.Description,
+				MarkdownDescription: typeDescription.MarkdownDescription,
+				Computed:            true,
+
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"status": schema.StringAttribute{
+				Description:         statusDescription.Description,
+				MarkdownDescription: statusDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				Default: stringdefault.StaticString(mfaDeviceStatusActive),
+
+				Validators: []validator.String{
+					stringvalidator.OneOf(mfaDeviceStatusActive, mfaDeviceStatusActivationRequired),
+				},
+			},
+
+			"nickname": schema.StringAttribute{
+				Description:         nicknameDescription.Description,
+				MarkdownDescription: nicknameDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"email": schema.SingleNestedAttribute{
+				Description:         emailDescription.Description,
+				MarkdownDescription: emailDescription.MarkdownDescription,
+				Optional:            true,
+
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("The email address that passcodes are sent to.").Description,
+						Required:    true,
+
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(attrMinLength),
+						},
+					},
+				},
+
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+
+				Validators: []validator.Object{
+					deviceTypeValidator,
+				},
+			},
+
+			"sms": schema.SingleNestedAttribute{
+				Description:         smsDescription.Description,
+				MarkdownDescription: smsDescription.MarkdownDescription,
+				Optional:            true,
+
+				Attributes: map[string]schema.Attribute{
+					"phone": schema.StringAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("The phone number that passcodes are sent to, including the country code, for example `+14155552671`.").Description,
+						Required:    true,
+
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(attrMinLength),
+						},
+					},
+				},
+
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+
+				Validators: []validator.Object{
+					deviceTypeValidator,
+				},
+			},
+
+			"totp": schema.SingleNestedAttribute{
+				Description:         totpDescription.Description,
+				MarkdownDescription: totpDescription.MarkdownDescription,
+				Optional:            true,
+
+				Attributes: map[string]schema.Attribute{
+					"secret": schema.StringAttribute{
+						Description:         totpSecretDescription.Description,
+						MarkdownDescription: totpSecretDescription.MarkdownDescription,
+						Computed:            true,
+						Sensitive:           true,
+
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+
+					"key_uri": schema.StringAttribute{
+						Description:         totpKeyUriDescription.Description,
+						MarkdownDescription: totpKeyUriDescription.MarkdownDescription,
+						Computed:            true,
+						Sensitive:           true,
+
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+
+				Validators: []validator.Object{
+					deviceTypeValidator,
+				},
+			},
+
+			"voice": schema.SingleNestedAttribute{
+				Description:         voiceDescription.Description,
+				MarkdownDescription: voiceDescription.MarkdownDescription,
+				Optional:            true,
+
+				Attributes: map[string]schema.Attribute{
+					"phone": schema.StringAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("The phone number that passcodes are read out to, including the country code, for example `+14155552671`.").Description,
+						Required:    true,
+
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(attrMinLength),
+						},
+					},
+
+					"extension": schema.StringAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("The phone extension to dial after the call is connected.").Description,
+						Optional:    true,
+
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(attrMinLength),
+						},
+					},
+				},
+
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+
+				Validators: []validator.Object{
+					deviceTypeValidator,
+				},
+			},
+		},
+	}
+}
+
+func (r *MFADeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *MFADeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state MFADeviceResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	device, d := plan.expand(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *mfaDevice
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := createMFADevice(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.UserId.ValueString(), *device)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"CreateMFAUserDevice",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret is only returned on create
+	totpSecret := response.Secret
+	totpKeyUri := response.KeyUri
+
+	// TOTP devices are always created pending activation, which needs a passcode from the device secret
+	if response.Type == mfaDeviceTypeTOTP && plan.Status.ValueString() == mfaDeviceStatusActive && response.Status == mfaDeviceStatusActivationRequired {
+		if totpSecret == nil {
+			resp.Diagnostics.AddError(
+				"Cannot activate TOTP device",
+				fmt.Sprintf("The TOTP device %s was created, but the service did not return a device secret to activate it with.  The device has been left with a status of %s.", response.Id, response.Status),
+			)
+			return
+		}
+
+		otp, err := generateTOTP(*totpSecret, time.Now())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Cannot activate TOTP device",
+				fmt.Sprintf("A passcode cannot be generated for the TOTP device %s: %s", response.Id, err),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := activateMFADevice(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.UserId.ValueString(), response.Id, otp)
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ActivateMFAUserDevice",
+			legacysdk.DefaultCustomError,
+			nil,
+			nil,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Nickname.IsNull() && !plan.Nickname.IsUnknown() {
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := updateMFADeviceNickname(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.UserId.ValueString(), response.Id, plan.Nickname.ValueString())
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"UpdateMFAUserDeviceNickname",
+			legacysdk.DefaultCustomError,
+			nil,
+			nil,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Read the device after activation and nickname updates
+	deviceID := response.Id
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readMFADevice(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.UserId.ValueString(), deviceID)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOneMFAUserDevice",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if response == nil {
+		resp.Diagnostics.AddError(
+			"MFA device not found",
+			fmt.Sprintf("The MFA device %s cannot be found after it was created.", deviceID),
+		)
+		return
+	}
+
+	response.Secret = totpSecret
+	response.KeyUri = totpKeyUri
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(ctx, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MFADeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *MFADeviceResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var response *mfaDevice
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := readMFADevice(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.UserId.ValueString(), data.Id.ValueString())
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOneMFAUserDevice",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if response == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The secret is not returned on read, so is retained from state
+	if !data.Totp.IsNull() && !data.Totp.IsUnknown() {
+		var totp MFADeviceTotpResourceModel
+		resp.Diagnostics.Append(data.Totp.As(ctx, &totp, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    false,
+			UnhandledUnknownAsEmpty: false,
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		response.Secret = totp.Secret.ValueStringPointer()
+		response.KeyUri = totp.KeyUri.ValueStringPointer()
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(ctx, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MFADeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state MFADeviceResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readDevice := func() (*mfaDevice, diag.Diagnostics) {
+		var response *mfaDevice
+		d := legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := readMFADevice(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.UserId.ValueString(), plan.Id.ValueString())
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneMFAUserDevice",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&response,
+		)
+
+		if !d.HasError() && response == nil {
+			d.AddError(
+				"MFA device not found",
+				fmt.Sprintf("The MFA device %s cannot be found.", plan.Id.ValueString()),
+			)
+		}
+
+		return response, d
+	}
+
+	response, d := readDevice()
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The status is only applied on create, so an existing device that still requires activation cannot be changed to active.  This is checked before any change is made to the device.
+	if !mfaDeviceStatusToTF(plan.Status, response.Status).Equal(plan.Status) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Cannot change MFA device status",
+			fmt.Sprintf("The MFA device %s has a status of %s.  The status of an MFA device is only applied when the device is created, so the device must be activated by the user, or the resource replaced, to change its status to %s.", plan.Id.ValueString(), response.Status, plan.Status.ValueString()),
+		)
+		return
+	}
+
+	// Only the nickname can be changed in place
+	if !plan.Nickname.IsNull() && !plan.Nickname.IsUnknown() && !plan.Nickname.Equal(state.Nickname) {
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := updateMFADeviceNickname(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.UserId.ValueString(), plan.Id.ValueString(), plan.Nickname.ValueString())
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"UpdateMFAUserDeviceNickname",
+			legacysdk.DefaultCustomError,
+			nil,
+			nil,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		response, d = readDevice()
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Totp.IsNull() && !plan.Totp.IsUnknown() {
+		var totp MFADeviceTotpResourceModel
+		resp.Diagnostics.Append(plan.Totp.As(ctx, &totp, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    false,
+			UnhandledUnknownAsEmpty: false,
+		})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		response.Secret = totp.Secret.ValueStringPointer()
+		response.KeyUri = totp.KeyUri.ValueStringPointer()
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(ctx, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *MFADeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *MFADeviceResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := deleteMFADevice(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.UserId.ValueString(), data.Id.ValueString())
+			if fR != nil && fR.StatusCode == http.StatusNotFound {
+				return nil, nil, nil
+			}
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, fR, fErr)
+		},
+		"DeleteMFAUserDevice",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		nil,
+		nil,
+	)...)
+}
+
+func (r *MFADeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:  "user_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "mfa_device_id",
+			Regexp:    verify.P1ResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathKey := idComponent.Label
+
+		if idComponent.PrimaryID {
+			pathKey = "id"
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathKey), attributes[idComponent.Label])...)
+	}
+}
+
+func (p *MFADeviceResourceModel) expand(ctx context.Context) (*mfaDevice, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &mfaDevice{
+		Status: p.Status.ValueString(),
+	}
+
+	if !p.MFADevicePolicyId.IsNull() && !p.MFADevicePolicyId.IsUnknown() {
+		data.Policy = &mfaDeviceResourceObject{
+			Id: p.MFADevicePolicyId.ValueString(),
+		}
+	}
+
+	if !p.Email.IsNull() && !p.Email.IsUnknown() {
+		var plan MFADeviceEmailResourceModel
+		diags.Append(p.Email.As(ctx, &plan, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    false,
+			UnhandledUnknownAsEmpty: false,
+		})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		data.Type = mfaDeviceTypeEmail
+		data.Email = plan.Email.ValueStringPointer()
+	}
+
+	if !p.Sms.IsNull() && !p.Sms.IsUnknown() {
+		var plan MFADeviceSmsResourceModel
+		diags.Append(p.Sms.As(ctx, &plan, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    false,
+			UnhandledUnknownAsEmpty: false,
+		})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		data.Type = mfaDeviceTypeSMS
+		data.Phone = plan.Phone.ValueStringPointer()
+	}
+
+	if !p.Voice.IsNull() && !p.Voice.IsUnknown() {
+		var plan MFADeviceVoiceResourceModel
+		diags.Append(p.Voice.As(ctx, &plan, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    false,
+			UnhandledUnknownAsEmpty: false,
+		})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		data.Type = mfaDeviceTypeVoice
+		data.Phone = plan.Phone.ValueStringPointer()
+
+		if !plan.Extension.IsNull() && !plan.Extension.IsUnknown() {
+			data.Extension = plan.Extension.ValueStringPointer()
+		}
+	}
+
+	if !p.Totp.IsNull() {
+		data.Type = mfaDeviceTypeTOTP
+
+		// TOTP devices can only be created pending activation
+		data.Status = mfaDeviceStatusActivationRequired
+	}
+
+	return data, diags
+}
+
+func (p *MFADeviceResourceModel) toState(ctx context.Context, apiObject *mfaDevice) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.Type = framework.StringToTF(apiObject.Type)
+	p.Status = mfaDeviceStatusToTF(p.Status, apiObject.Status)
+	p.Nickname = framework.StringOkToTF(apiObject.Nickname, apiObject.Nickname != nil)
+
+	p.MFADevicePolicyId = pingonetypes.NewResourceIDNull()
+	if apiObject.Policy != nil {
+		p.MFADevicePolicyId = framework.PingOneResourceIDToTF(apiObject.Policy.Id)
+	}
+
+	p.Email = types.ObjectNull(MFADeviceEmailTFObjectTypes)
+	p.Sms = types.ObjectNull(MFADeviceSmsTFObjectTypes)
+	p.Voice = types.ObjectNull(MFADeviceVoiceTFObjectTypes)
+	p.Totp = types.ObjectNull(MFADeviceTotpTFObjectTypes)
+
+	switch apiObject.Type {
+	case mfaDeviceTypeEmail:
+		p.Email, d = types.ObjectValue(MFADeviceEmailTFObjectTypes, map[string]attr.Value{
+			"email": framework.StringOkToTF(apiObject.Email, apiObject.Email != nil),
+		})
+		diags.Append(d...)
+	case mfaDeviceTypeSMS:
+		p.Sms, d = types.ObjectValue(MFADeviceSmsTFObjectTypes, map[string]attr.Value{
+			"phone": framework.StringOkToTF(apiObject.Phone, apiObject.Phone != nil),
+		})
+		diags.Append(d...)
+	case mfaDeviceTypeVoice:
+		p.Voice, d = types.ObjectValue(MFADeviceVoiceTFObjectTypes, map[string]attr.Value{
+			"phone":     framework.StringOkToTF(apiObject.Phone, apiObject.Phone != nil),
+			"extension": framework.StringOkToTF(apiObject.Extension, apiObject.Extension != nil),
+		})
+		diags.Append(d...)
+	case mfaDeviceTypeTOTP:
+		p.Totp, d = types.ObjectValue(MFADeviceTotpTFObjectTypes, map[string]attr.Value{
+			"secret":  framework.StringOkToTF(apiObject.Secret, apiObject.Secret != nil),
+			"key_uri": framework.StringOkToTF(apiObject.KeyUri, apiObject.KeyUri != nil),
+		})
+		diags.Append(d...)
+	default:
+		diags.AddError(
+			"Unsupported MFA device type",
+			fmt.Sprintf("The MFA device %s has a type of %s, which cannot be managed with this resource.", apiObject.Id, apiObject.Type),
+		)
+	}
+
+	return diags
+}
+
+// mfaDeviceStatusToTF returns the status to store in state from the configured (or prior) status and the status returned by the service.
+// A device that is active satisfies a configured status of ACTIVATION_REQUIRED, as the user may activate the device after it is created.
+func mfaDeviceStatusToTF(configuredStatus types.String, status string) types.String {
+	if configuredStatus.ValueString() == mfaDeviceStatusActivationRequired && status == mfaDeviceStatusActive {
+		return configuredStatus
+	}
+
+	return framework.StringToTF(status)
+}
@@ -0,0 +1,547 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package mfa_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	baselegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/mfa"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccMFADevice_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var mfaDeviceID, userID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Test removal of the resource
+			{
+				Config: testAccMFADeviceConfig_Email(resourceName, name),
+				Check:  mfa.MFADevice_GetIDs(resourceFullName, &environmentID, &userID, &mfaDeviceID),
+			},
+			{
+				PreConfig: func() {
+					mfa.MFADevice_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, userID, mfaDeviceID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the user
+			{
+				Config: testAccMFADeviceConfig_Email(resourceName, name),
+				Check:  mfa.MFADevice_GetIDs(resourceFullName, &environmentID, &userID, &mfaDeviceID),
+			},
+			{
+				PreConfig: func() {
+					sso.User_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, userID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the environment
+			{
+				Config: testAccMFADeviceConfig_NewEnv(environmentName, licenseID, resourceName, name),
+				Check:  mfa.MFADevice_GetIDs(resourceFullName, &environmentID, &userID, &mfaDeviceID),
+			},
+			{
+				PreConfig: func() {
+					baselegacysdk.Environment_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_Email(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADeviceConfig_Email(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrPair(resourceFullName, "user_id", fmt.Sprintf("pingone_user.%s", resourceName), "id"),
+					resource.TestCheckResourceAttr(resourceFullName, "type", "EMAIL"),
+					resource.TestCheckResourceAttr(resourceFullName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceFullName, "email.email", fmt.Sprintf("%s@pingidentity.com", name)),
+					resource.TestCheckNoResourceAttr(resourceFullName, "sms"),
+					resource.TestCheckNoResourceAttr(resourceFullName, "totp"),
+					resource.TestCheckNoResourceAttr(resourceFullName, "voice"),
+				),
+			},
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_SMS(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADeviceConfig_SMS(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(resourceFullName, "type", "SMS"),
+					resource.TestCheckResourceAttr(resourceFullName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceFullName, "sms.phone", "+14155552671"),
+					resource.TestCheckNoResourceAttr(resourceFullName, "email"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_Voice(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADeviceConfig_Voice(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(resourceFullName, "type", "VOICE"),
+					resource.TestCheckResourceAttr(resourceFullName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceFullName, "voice.phone", "+14155552671"),
+					resource.TestCheckResourceAttr(resourceFullName, "voice.extension", "1234"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_TOTP(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	var deviceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Activated by the provider
+			{
+				Config: testAccMFADeviceConfig_TOTP(resourceName, name, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrWith(resourceFullName, "id", func(value string) error {
+						deviceID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceFullName, "type", "TOTP"),
+					resource.TestCheckResourceAttr(resourceFullName, "status", "ACTIVE"),
+					resource.TestMatchResourceAttr(resourceFullName, "totp.secret", regexp.MustCompile(`^[A-Z2-7]+=*$`)),
+					resource.TestMatchResourceAttr(resourceFullName, "totp.key_uri", regexp.MustCompile(`^otpauth://totp/`)),
+				),
+			},
+			// The status is create-time only, and an active device satisfies ACTIVATION_REQUIRED without being replaced
+			{
+				Config: testAccMFADeviceConfig_TOTP(resourceName, name, "ACTIVATION_REQUIRED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceFullName, "id", func(value string) error {
+						if value != deviceID {
+							return fmt.Errorf("expected the MFA device %s to be updated in place, got %s", deviceID, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceFullName, "type", "TOTP"),
+					resource.TestCheckResourceAttr(resourceFullName, "status", "ACTIVATION_REQUIRED"),
+					resource.TestMatchResourceAttr(resourceFullName, "totp.secret", regexp.MustCompile(`^[A-Z2-7]+=*$`)),
+				),
+			},
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"status",
+					"totp.secret",
+					"totp.key_uri",
+				},
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_StatusChange(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Left pending activation
+			{
+				Config: testAccMFADeviceConfig_TOTP(resourceName, name, "ACTIVATION_REQUIRED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "type", "TOTP"),
+					resource.TestCheckResourceAttr(resourceFullName, "status", "ACTIVATION_REQUIRED"),
+				),
+			},
+			// The provider cannot activate an existing device
+			{
+				Config:      testAccMFADeviceConfig_TOTP(resourceName, name, "ACTIVE"),
+				ExpectError: regexp.MustCompile("Cannot change MFA device status"),
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_Nickname(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	var mfaDeviceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFADeviceConfig_Nickname(resourceName, name, "Work email"),
+				Check: resource.ComposeTestCheckFunc(
+					mfa.MFADevice_GetIDs(resourceFullName, nil, nil, &mfaDeviceID),
+					resource.TestCheckResourceAttr(resourceFullName, "nickname", "Work email"),
+				),
+			},
+			// Updated in place
+			{
+				Config: testAccMFADeviceConfig_Nickname(resourceName, name, "Personal email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "nickname", "Personal email"),
+					func(s *terraform.State) error {
+						if rs := s.RootModule().Resources[resourceFullName]; rs == nil || rs.Primary.ID != mfaDeviceID {
+							return fmt.Errorf("the MFA device was replaced when only the nickname changed")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccMFADevice_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_mfa_device.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             mfa.MFADevice_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMFADeviceConfig_MultipleTypes(resourceName, name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Configure
+			{
+				Config: testAccMFADeviceConfig_Email(resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccMFADeviceConfig_NewEnv(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_population" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_user" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  username      = "%[4]s"
+  email         = "%[4]s@pingidentity.com"
+  population_id = pingone_population.%[3]s.id
+}
+
+resource "pingone_mfa_device" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  user_id        = pingone_user.%[3]s.id
+
+  email = {
+    email = "%[4]s@pingidentity.com"
+  }
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
+
+func testAccMFADeviceConfig_User(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s"
+  email         = "%[3]s@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccMFADeviceConfig_Email(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  email = {
+    email = "%[3]s@pingidentity.com"
+  }
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName, name)
+}
+
+func testAccMFADeviceConfig_SMS(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  sms = {
+    phone = "+14155552671"
+  }
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName)
+}
+
+func testAccMFADeviceConfig_Voice(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  voice = {
+    phone     = "+14155552671"
+    extension = "1234"
+  }
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName)
+}
+
+func testAccMFADeviceConfig_TOTP(resourceName, name, status string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  status = "%[3]s"
+
+  totp = {}
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName, status)
+}
+
+func testAccMFADeviceConfig_Nickname(resourceName, name, nickname string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  nickname = "%[4]s"
+
+  email = {
+    email = "%[3]s@pingidentity.com"
+  }
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName, name, nickname)
+}
+
+func testAccMFADeviceConfig_MultipleTypes(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_mfa_device" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  email = {
+    email = "%[3]s@pingidentity.com"
+  }
+
+  sms = {
+    phone = "+14155552671"
+  }
+}`, testAccMFADeviceConfig_User(resourceName, name), resourceName, name)
+}
@@ -16,6 +16,7 @@ func Resources() []func() resource.Resource {
 	resources := []func() resource.Resource{
 		NewApplicationPushCredentialResource,
 		NewFIDO2PolicyResource,
+		NewMFADeviceResource,
 		NewMFADevicePolicyResource,
 		NewMFADevicePolicyDefaultResource,
 		NewMFASettingsResource,
@@ -27,7 +28,11 @@ func Resources() []func() resource.Resource {
 
 func DataSources() []func() datasource.DataSource {
 	dataSources := []func() datasource.DataSource{
+		NewFIDO2PoliciesDataSource,
+		NewFIDO2PolicyDataSource,
 		NewMFADevicePoliciesDataSource,
+		NewMFADevicePolicyDataSource,
+		NewUserMFADevicesDataSource,
 	}
 	dataSources = append(dataSources, BetaDataSources()...)
 
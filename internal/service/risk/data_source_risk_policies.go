@@ -0,0 +1,176 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package risk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+// Types
+type RiskPoliciesDataSource serviceClientType
+
+type RiskPoliciesDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	Ids           types.List                   `tfsdk:"ids"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &RiskPoliciesDataSource{}
+)
+
+// New Object
+func NewRiskPoliciesDataSource() datasource.DataSource {
+	return &RiskPoliciesDataSource{}
+}
+
+// Metadata
+func (r *RiskPoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_risk_policies"
+}
+
+// Schema
+func (r *RiskPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve the IDs of multiple PingOne Risk policies.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to select risk policies from."),
+			),
+
+			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
+				"The list of resulting IDs of risk policies that have been successfully retrieved and filtered.",
+			)),
+		},
+	}
+}
+
+func (r *RiskPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *RiskPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *RiskPoliciesDataSourceModel
+
+	if r.Client.RiskAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filterFunction := func() (any, *http.Response, error) {
+		pagedIterator := r.Client.RiskAPIClient.RiskPoliciesApi.ReadRiskPolicySets(ctx, data.EnvironmentId.ValueString()).Execute()
+
+		riskPolicyIDs := make([]string, 0)
+
+		var initialHttpResponse *http.Response
+
+		for pageCursor, err := range pagedIterator {
+			if err != nil {
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+			}
+
+			if initialHttpResponse == nil {
+				initialHttpResponse = pageCursor.HTTPResponse
+			}
+
+			if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.RiskPolicySets != nil {
+				for _, policy := range pageCursor.EntityArray.Embedded.GetRiskPolicySets() {
+					riskPolicyIDs = append(riskPolicyIDs, policy.GetId())
+				}
+			}
+		}
+
+		return riskPolicyIDs, initialHttpResponse, nil
+	}
+
+	var riskPolicyIDs []string
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		filterFunction,
+		"ReadRiskPolicySets",
+		legacysdk.DefaultCustomError,
+		nil,
+		&riskPolicyIDs,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(riskPolicyIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *RiskPoliciesDataSourceModel) toState(apiObject []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	var d diag.Diagnostics
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	p.EnvironmentId = framework.PingOneResourceIDToTF(p.EnvironmentId.ValueString())
+
+	p.Ids, d = framework.StringSliceToTF(apiObject)
+	diags.Append(d...)
+
+	return diags
+}
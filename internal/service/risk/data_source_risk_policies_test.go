@@ -0,0 +1,104 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package risk_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/risk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccRiskPoliciesDataSource_ByAll(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_risk_policies.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             risk.RiskPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskPoliciesDataSourceConfig_ByAll(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", "pingone_risk_policy."+resourceName+"-1", "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFullName, "ids.*", "pingone_risk_policy."+resourceName+"-2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRiskPoliciesDataSourceConfig_ByAll(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_risk_policy" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-1"
+
+  policy_scores = {
+    policy_threshold_medium = {
+      min_score = 40
+    }
+
+    policy_threshold_high = {
+      min_score = 75
+    }
+
+    predictors = [
+      {
+        compact_name = "ipRisk"
+        score        = 50
+      }
+    ]
+  }
+}
+
+resource "pingone_risk_policy" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-2"
+
+  policy_scores = {
+    policy_threshold_medium = {
+      min_score = 40
+    }
+
+    policy_threshold_high = {
+      min_score = 75
+    }
+
+    predictors = [
+      {
+        compact_name = "ipRisk"
+        score        = 50
+      }
+    ]
+  }
+}
+
+data "pingone_risk_policies" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  depends_on = [
+    pingone_risk_policy.%[2]s-1,
+    pingone_risk_policy.%[2]s-2,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
@@ -0,0 +1,355 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package risk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/risk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type RiskPolicyDataSource serviceClientType
+
+type riskPolicyDataSourceModel struct {
+	Id                  pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId       pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	RiskPolicyId        pingonetypes.ResourceIDValue `tfsdk:"risk_policy_id"`
+	Name                types.String                 `tfsdk:"name"`
+	DefaultResult       types.Object                 `tfsdk:"default_result"`
+	Default             types.Bool                   `tfsdk:"default"`
+	EvaluatedPredictors types.Set                    `tfsdk:"evaluated_predictors"`
+	PolicyWeights       types.Object                 `tfsdk:"policy_weights"`
+	PolicyScores        types.Object                 `tfsdk:"policy_scores"`
+	Overrides           types.List                   `tfsdk:"overrides"`
+	Mitigations         types.List                   `tfsdk:"mitigations"`
+	Fallback            types.Object                 `tfsdk:"fallback"`
+	Targets             types.Object                 `tfsdk:"targets"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource              = &RiskPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &RiskPolicyDataSource{}
+)
+
+// New Object
+func NewRiskPolicyDataSource() datasource.DataSource {
+	return &RiskPolicyDataSource{}
+}
+
+// Metadata
+func (r *RiskPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_risk_policy"
+}
+
+// Schema
+func (r *RiskPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	dataSourceExactlyOneOfRelativePaths := []string{
+		"risk_policy_id",
+		"name",
+		"default",
+	}
+
+	riskPolicyIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the risk policy to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	nameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The name of the risk policy to retrieve.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	defaultDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"Set value to `true` to return the default risk policy. There is only one default policy per environment.",
+	).ExactlyOneOf(dataSourceExactlyOneOfRelativePaths)
+
+	// The returned policy attributes are taken from the pingone_risk_policy resource schema, so the data source stays in step with the resource
+	resourceSchemaResp := resource.SchemaResponse{}
+	(&RiskPolicyResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+	resp.Diagnostics.Append(resourceSchemaResp.Diagnostics...)
+
+	attributes, d := framework.DataSourceComputedAttributesFromResourceAttributes(resourceSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes["id"] = framework.Attr_ID()
+
+	attributes["environment_id"] = framework.Attr_LinkID(
+		framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to retrieve the risk policy from."),
+	)
+
+	attributes["risk_policy_id"] = schema.StringAttribute{
+		Description:         riskPolicyIdDescription.Description,
+		MarkdownDescription: riskPolicyIdDescription.MarkdownDescription,
+		Optional:            true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("name"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+		},
+	}
+
+	attributes["name"] = schema.StringAttribute{
+		Description:         nameDescription.Description,
+		MarkdownDescription: nameDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("risk_policy_id"),
+				path.MatchRelative().AtParent().AtName("default"),
+			),
+			stringvalidator.LengthAtLeast(attrMinLength),
+		},
+	}
+
+	attributes["default"] = schema.BoolAttribute{
+		Description:         defaultDescription.Description,
+		MarkdownDescription: defaultDescription.MarkdownDescription,
+		Optional:            true,
+
+		Validators: []validator.Bool{
+			boolvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("risk_policy_id"),
+				path.MatchRelative().AtParent().AtName("name"),
+			),
+			boolvalidator.Equals(true),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to retrieve the default PingOne Risk policy or to find a PingOne Risk policy by its ID or name.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *RiskPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *RiskPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *riskPolicyDataSourceModel
+
+	if r.Client == nil || r.Client.RiskAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var riskPolicy *risk.RiskPolicySet
+
+	if !data.RiskPolicyId.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.RiskAPIClient.RiskPoliciesApi.ReadOneRiskPolicySet(ctx, data.EnvironmentId.ValueString(), data.RiskPolicyId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOneRiskPolicySet",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&riskPolicy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.Name.IsNull() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return r.findRiskPolicy(ctx, data.EnvironmentId.ValueString(), func(policy risk.RiskPolicySet) bool {
+					return strings.EqualFold(policy.GetName(), data.Name.ValueString())
+				})
+			},
+			"ReadRiskPolicySets",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&riskPolicy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if riskPolicy == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find risk policy from name",
+				fmt.Sprintf("The risk policy name %s for environment %s cannot be found", data.Name.String(), data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else if data.Default.ValueBool() {
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				return r.findRiskPolicy(ctx, data.EnvironmentId.ValueString(), func(policy risk.RiskPolicySet) bool {
+					return policy.GetDefault()
+				})
+			},
+			"ReadRiskPolicySets",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&riskPolicy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if riskPolicy == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find default risk policy",
+				fmt.Sprintf("The default risk policy for environment %s cannot be found", data.EnvironmentId.String()),
+			)
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested PingOne Risk policy: risk_policy_id, name, or default argument must be set.",
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(riskPolicy)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *riskPolicyDataSourceModel) toState(apiObject *risk.RiskPolicySet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	resourceModel := &riskPolicyResourceModel{}
+	diags.Append(resourceModel.toState(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	p.Id = resourceModel.Id
+	p.EnvironmentId = resourceModel.EnvironmentId
+	p.RiskPolicyId = resourceModel.Id
+	p.Name = resourceModel.Name
+	p.DefaultResult = resourceModel.DefaultResult
+	p.Default = resourceModel.Default
+	p.EvaluatedPredictors = resourceModel.EvaluatedPredictors
+	p.Overrides = resourceModel.Overrides
+	p.Mitigations = resourceModel.Mitigations
+	p.Fallback = resourceModel.Fallback
+	p.Targets = resourceModel.Targets
+
+	// The resource model leaves the policy weights and scores unknown where the API returns no policies, which isn't valid data source state
+	p.PolicyWeights = resourceModel.PolicyWeights
+	if p.PolicyWeights.IsUnknown() {
+		p.PolicyWeights = types.ObjectNull(policyWeightsTFObjectTypes)
+	}
+
+	p.PolicyScores = resourceModel.PolicyScores
+	if p.PolicyScores.IsUnknown() {
+		p.PolicyScores = types.ObjectNull(policyScoresTFObjectTypes)
+	}
+
+	if p.Overrides.IsUnknown() {
+		p.Overrides = types.ListNull(types.ObjectType{AttrTypes: overridesTFObjectTypes})
+	}
+
+	return diags
+}
+
+func (r *RiskPolicyDataSource) findRiskPolicy(ctx context.Context, environmentID string, matcher func(risk.RiskPolicySet) bool) (*risk.RiskPolicySet, *http.Response, error) {
+	pagedIterator := r.Client.RiskAPIClient.RiskPoliciesApi.ReadRiskPolicySets(ctx, environmentID).Execute()
+
+	var initialHttpResponse *http.Response
+
+	for pageCursor, err := range pagedIterator {
+		if err != nil {
+			_, resp, err := legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, environmentID, nil, pageCursor.HTTPResponse, err)
+			return nil, resp, err
+		}
+
+		if initialHttpResponse == nil {
+			initialHttpResponse = pageCursor.HTTPResponse
+		}
+
+		if policies, ok := pageCursor.EntityArray.Embedded.GetRiskPolicySetsOk(); ok {
+			for _, policy := range policies {
+				if matcher(policy) {
+					return &policy, pageCursor.HTTPResponse, nil
+				}
+			}
+		}
+	}
+
+	return nil, initialHttpResponse, nil
+}
@@ -0,0 +1,266 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package risk_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/risk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccRiskPolicyDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_risk_policy.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_risk_policy.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             risk.RiskPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskPolicyDataSourceConfig_ByIDFull(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "risk_policy_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "default_result.level", resourceFullName, "default_result.level"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "evaluated_predictors.#", "2"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "policy_weights"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "policy_scores.policy_threshold_medium.min_score", "40"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "policy_scores.policy_threshold_high.min_score", "75"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "policy_scores.predictors.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "policy_scores.predictors.*", map[string]string{
+						"compact_name": "ipRisk",
+						"score":        "50",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFullName, "policy_scores.predictors.*", map[string]string{
+						"compact_name": "geoVelocity",
+						"score":        "50",
+					}),
+					resource.TestCheckResourceAttr(dataSourceFullName, "overrides.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRiskPolicyDataSource_ByNameFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_risk_policy.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.pingone_risk_policy.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             risk.RiskPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskPolicyDataSourceConfig_ByNameFull(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "risk_policy_id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "policy_scores.predictors.#", "2"),
+				),
+			},
+			{
+				Config: testAccRiskPolicyDataSourceConfig_ByNameFull(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "name", resourceFullName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRiskPolicyDataSource_ByDefault(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_risk_policy.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             risk.RiskPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskPolicyDataSourceConfig_ByDefault(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "risk_policy_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrSet(dataSourceFullName, "name"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRiskPolicyDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             risk.RiskPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRiskPolicyDataSourceConfig_NotFoundByName(resourceName),
+				ExpectError: regexp.MustCompile("Cannot find risk policy from name"),
+			},
+			{
+				Config:      testAccRiskPolicyDataSourceConfig_DefaultFalse(resourceName),
+				ExpectError: regexp.MustCompile(`Attribute default Value must be "true", got: false`),
+			},
+			{
+				Config:      testAccRiskPolicyDataSourceConfig_NotFoundByID(resourceName),
+				ExpectError: regexp.MustCompile("Error when calling `ReadOneRiskPolicySet`: The request could not be completed. The requested resource was not found."),
+			},
+		},
+	})
+}
+
+func testAccRiskPolicyDataSourceConfig_Policy(resourceName, name string) string {
+	return fmt.Sprintf(`
+resource "pingone_risk_policy" "%[1]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[2]s"
+
+  policy_scores = {
+    policy_threshold_medium = {
+      min_score = 40
+    }
+
+    policy_threshold_high = {
+      min_score = 75
+    }
+
+    predictors = [
+      {
+        compact_name = "ipRisk"
+        score        = 50
+      },
+      {
+        compact_name = "geoVelocity"
+        score        = 50
+      }
+    ]
+  }
+}`, resourceName, name)
+}
+
+func testAccRiskPolicyDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_risk_policy" "%[3]s" {
+  environment_id       = data.pingone_environment.general_test.id
+  risk_policy_id = pingone_risk_policy.%[3]s.id
+}`, acctest.GenericSandboxEnvironment(), testAccRiskPolicyDataSourceConfig_Policy(resourceName, name), resourceName)
+}
+
+func testAccRiskPolicyDataSourceConfig_ByNameFull(resourceName, name string, insensitivityCheck bool) string {
+
+	// If insensitivityCheck is true, alter the case of the name
+	nameComparator := name
+	if insensitivityCheck {
+		nameComparator = acctest.AlterStringCasing(nameComparator)
+	}
+
+	return fmt.Sprintf(`
+	%[1]s
+
+%[2]s
+
+data "pingone_risk_policy" "%[3]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[4]s"
+
+  depends_on = [pingone_risk_policy.%[3]s]
+}`, acctest.GenericSandboxEnvironment(), testAccRiskPolicyDataSourceConfig_Policy(resourceName, name), resourceName, nameComparator)
+}
+
+func testAccRiskPolicyDataSourceConfig_ByDefault(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_risk_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  default        = true
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccRiskPolicyDataSourceConfig_NotFoundByName(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_risk_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "doesnotexist"
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccRiskPolicyDataSourceConfig_NotFoundByID(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_risk_policy" "%[2]s" {
+  environment_id       = data.pingone_environment.general_test.id
+  risk_policy_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccRiskPolicyDataSourceConfig_DefaultFalse(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_risk_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  default        = false
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -24,6 +24,8 @@ func Resources() []func() resource.Resource {
 
 func DataSources() []func() datasource.DataSource {
 	dataSources := []func() datasource.DataSource{
+		NewRiskPoliciesDataSource,
+		NewRiskPolicyDataSource,
 		NewRiskPredictorDataSource,
 		NewRiskPredictorsDataSource,
 	}
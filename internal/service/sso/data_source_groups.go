@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/patrickcping/pingone-go-sdk-v2/management"
@@ -23,11 +25,13 @@ import (
 type GroupsDataSource serviceClientType
 
 type GroupsDataSourceModel struct {
-	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
-	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
-	ScimFilter    types.String                 `tfsdk:"scim_filter"`
-	DataFilters   types.List                   `tfsdk:"data_filters"`
-	Ids           types.List                   `tfsdk:"ids"`
+	EnvironmentId  pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	Id             pingonetypes.ResourceIDValue `tfsdk:"id"`
+	ScimFilter     types.String                 `tfsdk:"scim_filter"`
+	DataFilters    types.List                   `tfsdk:"data_filters"`
+	Ids            types.List                   `tfsdk:"ids"`
+	IncludeDetails types.Bool                   `tfsdk:"include_details"`
+	Items          types.List                   `tfsdk:"items"`
 }
 
 // Framework interfaces
@@ -50,6 +54,36 @@ func (r *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 
 	filterableAttributes := []string{"id", "name", "population.id", "externalId"}
 
+	// The returned group attributes are taken from the pingone_group data source schema, so each item has the same shape as the singular data source
+	groupSchemaResp := datasource.SchemaResponse{}
+	(&GroupDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &groupSchemaResp)
+	resp.Diagnostics.Append(groupSchemaResp.Diagnostics...)
+
+	itemAttributes, d := framework.DataSourceComputedAttributesFromDataSourceAttributes(groupSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemAttributes["environment_id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that the group belongs to.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	itemAttributes["group_id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the group.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	itemAttributes["name"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The name of the group.").Description,
+		Computed:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Datasource to filter and retrieve multiple PingOne groups in an environment.",
@@ -81,6 +115,14 @@ func (r *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
 				"The list of resulting IDs of groups that have been successfully retrieved and filtered.",
 			)),
+
+			"include_details": framework.Attr_DataSourceIncludeDetails(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A boolean that specifies whether the full details of each group should be returned in the `items` attribute.  The details are taken from the same paged list response used to populate `ids`, so no additional API calls are made.",
+			)),
+
+			"items": framework.Attr_DataSourceReturnItems(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A list of objects that describe the groups that have been successfully retrieved and filtered, with the same attributes as the `pingone_group` data source.  Only populated when `include_details` is set to `true`.",
+			), itemAttributes),
 		},
 	}
 }
@@ -173,7 +215,7 @@ func (r *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	var groupIDs []string
+	var groups []management.Group
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
@@ -182,7 +224,7 @@ func (r *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 			var initialHttpResponse *http.Response
 
-			foundIDs := make([]string, 0)
+			foundGroups := make([]management.Group, 0)
 
 			for pageCursor, err := range pagedIterator {
 				if err != nil {
@@ -194,32 +236,36 @@ func (r *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				}
 
 				if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Groups != nil {
-					for _, item := range pageCursor.EntityArray.Embedded.GetGroups() {
-						foundIDs = append(foundIDs, item.GetId())
-					}
+					foundGroups = append(foundGroups, pageCursor.EntityArray.Embedded.GetGroups()...)
 				}
 			}
 
-			return foundIDs, initialHttpResponse, nil
+			return foundGroups, initialHttpResponse, nil
 		},
 		"ReadAllGroups",
 		legacysdk.DefaultCustomError,
 		nil,
-		&groupIDs,
+		&groups,
 	)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	itemsType, d := req.Config.Schema.TypeAtPath(ctx, path.Root("items"))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(data.toState(data.EnvironmentId.ValueString(), groupIDs)...)
+	resp.Diagnostics.Append(data.toState(ctx, data.EnvironmentId.ValueString(), groups, itemsType)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (p *GroupsDataSourceModel) toState(environmentID string, groupIDs []string) diag.Diagnostics {
+func (p *GroupsDataSourceModel) toState(ctx context.Context, environmentID string, groups []management.Group, itemsType attr.Type) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if groupIDs == nil || environmentID == "" {
+	if groups == nil || environmentID == "" {
 		diags.AddError(
 			"Data object missing",
 			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
@@ -230,9 +276,35 @@ func (p *GroupsDataSourceModel) toState(environmentID string, groupIDs []string)
 
 	var d diag.Diagnostics
 
+	groupIDs := make([]string, 0, len(groups))
+	var items []GroupDataSourceModel
+
+	if p.IncludeDetails.ValueBool() {
+		items = make([]GroupDataSourceModel, 0, len(groups))
+	}
+
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.GetId())
+
+		if items != nil {
+			item := GroupDataSourceModel{
+				EnvironmentId: framework.PingOneResourceIDToTF(environmentID),
+			}
+			diags.Append(item.toState(&group)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			items = append(items, item)
+		}
+	}
+
 	p.Id = framework.PingOneResourceIDToTF(environmentID)
 	p.Ids, d = framework.StringSliceToTF(groupIDs)
 	diags.Append(d...)
 
+	p.Items, d = framework.DataSourceItemsToTF(ctx, itemsType, items)
+	diags.Append(d...)
+
 	return diags
 }
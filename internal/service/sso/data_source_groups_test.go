@@ -38,6 +38,43 @@ func TestAccGroupsDataSource_BySCIMFilter(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.0", verify.P1ResourceIDRegexpFullString),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.1", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupsDataSource_IncludeDetails(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_groups.%s", resourceName)
+	groupResourceFullName := fmt.Sprintf("pingone_group.%s-1", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.Group_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSourceConfig_IncludeDetails(resourceName, fmt.Sprintf(`name eq \"%s-1\"`, name), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.id", groupResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.group_id", groupResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.environment_id", groupResourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.name", groupResourceFullName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.description", groupResourceFullName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.external_id", groupResourceFullName, "external_id"),
 				),
 			},
 		},
@@ -150,6 +187,30 @@ data "pingone_groups" "%[2]s" {
 `, acctest.GenericSandboxEnvironment(), resourceName, name, filter)
 }
 
+func testAccGroupsDataSourceConfig_IncludeDetails(resourceName, filter, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_group" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s-1"
+  description    = "Test group"
+  external_id    = "%[3]s-external"
+}
+
+data "pingone_groups" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  scim_filter     = "%[4]s"
+  include_details = true
+
+  depends_on = [
+    pingone_group.%[2]s-1,
+  ]
+}
+`, acctest.GenericSandboxEnvironment(), resourceName, name, filter)
+}
+
 func testAccGroupsDataSourceConfig_ByDataFilter1(resourceName, name string) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -0,0 +1,643 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type PasswordPolicyEvaluationDataSource serviceClientType
+
+type PasswordPolicyEvaluationDataSourceModel struct {
+	Id               pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId    pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	PasswordPolicyId pingonetypes.ResourceIDValue `tfsdk:"password_policy_id"`
+	PasswordPolicy   types.Object                 `tfsdk:"password_policy"`
+	TestPasswords    types.List                   `tfsdk:"test_passwords"`
+	Valid            types.Bool                   `tfsdk:"valid"`
+	Results          types.List                   `tfsdk:"results"`
+}
+
+type passwordPolicyEvaluationPolicyDataSourceModel struct {
+	ExcludesCommonlyUsedPasswords types.Bool   `tfsdk:"excludes_commonly_used_passwords"`
+	ExcludesProfileData           types.Bool   `tfsdk:"excludes_profile_data"`
+	AlphabetSequenceRule          types.Object `tfsdk:"alphabet_sequence_rule"`
+	History                       types.Object `tfsdk:"history"`
+	Length                        types.Object `tfsdk:"length"`
+	Lockout                       types.Object `tfsdk:"lockout"`
+	MinCharacters                 types.Object `tfsdk:"min_characters"`
+	NumberSequenceRule            types.Object `tfsdk:"number_sequence_rule"`
+	QwertySequenceRule            types.Object `tfsdk:"qwerty_sequence_rule"`
+	ShiftedNumberRowSequenceRule  types.Object `tfsdk:"shifted_number_row_sequence_rule"`
+	PasswordAgeMax                types.Int32  `tfsdk:"password_age_max"`
+	PasswordAgeMin                types.Int32  `tfsdk:"password_age_min"`
+	MaxRepeatedCharacters         types.Int32  `tfsdk:"max_repeated_characters"`
+	MinComplexity                 types.Int32  `tfsdk:"min_complexity"`
+	MinUniqueCharacters           types.Int32  `tfsdk:"min_unique_characters"`
+	NotSimilarToCurrent           types.Bool   `tfsdk:"not_similar_to_current"`
+}
+
+type passwordPolicyEvaluationTestPasswordDataSourceModel struct {
+	Password               types.String `tfsdk:"password"`
+	CurrentPassword        types.String `tfsdk:"current_password"`
+	PreviousPasswords      types.List   `tfsdk:"previous_passwords"`
+	ProfileValues          types.List   `tfsdk:"profile_values"`
+	CurrentPasswordAgeDays types.Int32  `tfsdk:"current_password_age_days"`
+	FailedAttempts         types.Int32  `tfsdk:"failed_attempts"`
+}
+
+var (
+	passwordPolicyEvaluationResultsTFObjectTypes = map[string]attr.Type{
+		"valid":                    types.BoolType,
+		"violations":               types.ListType{ElemType: types.StringType},
+		"unevaluated_rules":        types.ListType{ElemType: types.StringType},
+		"current_password_expired": types.BoolType,
+		"locked_out":               types.BoolType,
+	}
+)
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &PasswordPolicyEvaluationDataSource{}
+)
+
+// New Object
+func NewPasswordPolicyEvaluationDataSource() datasource.DataSource {
+	return &PasswordPolicyEvaluationDataSource{}
+}
+
+// Metadata
+func (r *PasswordPolicyEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy_evaluation"
+}
+
+// Schema
+func (r *PasswordPolicyEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	environmentIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the environment that contains the password policy.  Required when `password_policy_id` is set.",
+	).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	passwordPolicyIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of an existing password policy to evaluate the test passwords against.",
+	).ExactlyOneOf([]string{"password_policy_id", "password_policy"}).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	passwordPolicyDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A single object that specifies the rules of a password policy to evaluate the test passwords against, without the policy needing to exist.  The attributes have the same meaning as those of the `pingone_password_policy` resource, but are not defaulted, so a rule is only evaluated where it is set.",
+	).ExactlyOneOf([]string{"password_policy_id", "password_policy"})
+
+	testPasswordsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of objects that specify the test passwords to evaluate, with the user context needed by the rules that depend on the user.",
+	)
+
+	resultsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of objects that describe the outcome of the evaluation for each test password, in the same order as `test_passwords`.  Rules are identified by the name of the password policy attribute that defines them, for example `length.min`, `min_characters.numeric` or `qwerty_sequence_rule`.",
+	)
+
+	sequenceRuleAttributes := func(description string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"max_length": schema.Int32Attribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown(description).Description,
+				Optional:    true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to evaluate test passwords against the rules of a PingOne password policy.  The evaluation is computed by the provider from the policy's configuration, and no passwords are sent to the PingOne service.  The `min_complexity` and `not_similar_to_current` rules are approximations, as the service does not publish the exact calculations that it uses, and the `excludes_commonly_used_passwords` rule cannot be evaluated.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": schema.StringAttribute{
+				Description:         environmentIdDescription.Description,
+				MarkdownDescription: environmentIdDescription.MarkdownDescription,
+				Optional:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+
+			"password_policy_id": schema.StringAttribute{
+				Description:         passwordPolicyIdDescription.Description,
+				MarkdownDescription: passwordPolicyIdDescription.MarkdownDescription,
+				Optional:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_policy")),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("environment_id")),
+				},
+			},
+
+			"password_policy": schema.SingleNestedAttribute{
+				Description:         passwordPolicyDescription.Description,
+				MarkdownDescription: passwordPolicyDescription.MarkdownDescription,
+				Optional:            true,
+
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_policy_id")),
+				},
+
+				Attributes: map[string]schema.Attribute{
+					"excludes_commonly_used_passwords": schema.BoolAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether to ensure the password is not one of the commonly used passwords.").Description,
+						Optional:    true,
+					},
+
+					"excludes_profile_data": schema.BoolAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether to ensure the password is not an exact match for the value of any attribute in the user's profile.").Description,
+						Optional:    true,
+					},
+
+					"alphabet_sequence_rule": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies options to control sequential English-letter checks for passwords.").Description,
+						Optional:    true,
+						Attributes:  sequenceRuleAttributes("An integer that specifies the maximum number of allowed sequential English letters in the password."),
+					},
+
+					"history": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies settings to control the user's password history.").Description,
+						Optional:    true,
+
+						Attributes: map[string]schema.Attribute{
+							"count": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the number of prior passwords to keep for prevention of password re-use.").Description,
+								Optional:    true,
+							},
+
+							"retention_days": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the length of time to keep recent passwords for prevention of password re-use.  This is not evaluated.").Description,
+								Optional:    true,
+							},
+						},
+					},
+
+					"length": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies settings to control the user's password length.").Description,
+						Optional:    true,
+
+						Attributes: map[string]schema.Attribute{
+							"max": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the maximum number of characters allowed for the password.").Description,
+								Optional:    true,
+							},
+
+							"min": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the minimum number of characters required for the password.").Description,
+								Optional:    true,
+							},
+						},
+					},
+
+					"lockout": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies settings to control the user's lockout on unsuccessful authentication attempts.").Description,
+						Optional:    true,
+
+						Attributes: map[string]schema.Attribute{
+							"duration_seconds": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the length of time before a password is automatically moved out of the lock out state.").Description,
+								Optional:    true,
+							},
+
+							"failure_count": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the number of tries before a password is placed in the lockout state.").Description,
+								Optional:    true,
+							},
+						},
+					},
+
+					"min_characters": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies the minimum number of times characters from each character set must appear in the password.").Description,
+						Optional:    true,
+
+						Attributes: map[string]schema.Attribute{
+							"alphabetical_uppercase": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the count of alphabetical uppercase characters (`ABCDEFGHIJKLMNOPQRSTUVWXYZ`) that should feature in the user's password.").Description,
+								Optional:    true,
+
+								Validators: []validator.Int32{
+									int32validator.Between(minCharactersFixedValue, maxCharactersFixedValue),
+								},
+							},
+
+							"alphabetical_lowercase": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the count of alphabetical lowercase characters (`abcdefghijklmnopqrstuvwxyz`) that should feature in the user's password.").Description,
+								Optional:    true,
+
+								Validators: []validator.Int32{
+									int32validator.Between(minCharactersFixedValue, maxCharactersFixedValue),
+								},
+							},
+
+							"numeric": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the count of numeric characters (`0123456789`) that should feature in the user's password.").Description,
+								Optional:    true,
+
+								Validators: []validator.Int32{
+									int32validator.Between(minCharactersFixedValue, maxCharactersFixedValue),
+								},
+							},
+
+							"special_characters": schema.Int32Attribute{
+								Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the count of special characters (`~!@#$%^&*()-_=+[]{}\\|;:,.<>/?`) that should feature in the user's password.").Description,
+								Optional:    true,
+
+								Validators: []validator.Int32{
+									int32validator.Between(minCharactersFixedValue, maxCharactersFixedValue),
+								},
+							},
+						},
+					},
+
+					"number_sequence_rule": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies options to control sequential number checks for passwords.").Description,
+						Optional:    true,
+						Attributes:  sequenceRuleAttributes("An integer that specifies the maximum number of allowed sequential numbers in the password."),
+					},
+
+					"qwerty_sequence_rule": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies options to control sequential QWERTY keyboard checks for passwords.").Description,
+						Optional:    true,
+						Attributes:  sequenceRuleAttributes("An integer that specifies the maximum number of allowed sequential QWERTY keyboard characters in the password."),
+					},
+
+					"shifted_number_row_sequence_rule": schema.SingleNestedAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A single object that specifies options to control sequential symbol row character checks for passwords.").Description,
+						Optional:    true,
+						Attributes:  sequenceRuleAttributes("An integer that specifies the maximum number of allowed sequential symbol row characters in the password."),
+					},
+
+					"password_age_max": schema.Int32Attribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the maximum number of days the same password can be used before it must be changed.").Description,
+						Optional:    true,
+					},
+
+					"password_age_min": schema.Int32Attribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the minimum number of days a password must be used before changing.").Description,
+						Optional:    true,
+					},
+
+					"max_repeated_characters": schema.Int32Attribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the maximum number of repeated characters allowed.").Description,
+						Optional:    true,
+					},
+
+					"min_complexity": schema.Int32Attribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the minimum complexity of the password based on the concept of password haystacks.").Description,
+						Optional:    true,
+					},
+
+					"min_unique_characters": schema.Int32Attribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("An integer that specifies the minimum number of unique characters required.").Description,
+						Optional:    true,
+					},
+
+					"not_similar_to_current": schema.BoolAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that specifies whether to ensure that the proposed password is not too similar to the user's current password.").Description,
+						Optional:    true,
+					},
+				},
+			},
+
+			"test_passwords": schema.ListNestedAttribute{
+				Description:         testPasswordsDescription.Description,
+				MarkdownDescription: testPasswordsDescription.MarkdownDescription,
+				Required:            true,
+
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"password": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The test password to evaluate.").Description,
+							Required:    true,
+							Sensitive:   true,
+						},
+
+						"current_password": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The user's current password, used to evaluate the `not_similar_to_current` rule.  When not set, the rule is reported as unevaluated.").Description,
+							Optional:    true,
+							Sensitive:   true,
+						},
+
+						"previous_passwords": schema.ListAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The user's previous passwords, most recent first, used to evaluate the `history` rule.  When not set, the rule is reported as unevaluated.").Description,
+							Optional:    true,
+							Sensitive:   true,
+
+							ElementType: types.StringType,
+						},
+
+						"profile_values": schema.ListAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The values of the user's profile attributes, used to evaluate the `excludes_profile_data` rule.  The rule is violated when the password contains any of the values, compared case-insensitively.  When not set, the rule is reported as unevaluated.").Description,
+							Optional:    true,
+
+							ElementType: types.StringType,
+						},
+
+						"current_password_age_days": schema.Int32Attribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The age of the user's current password in days, used to evaluate the `password_age_min` rule and the `current_password_expired` result.").Description,
+							Optional:    true,
+						},
+
+						"failed_attempts": schema.Int32Attribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A number of consecutive unsuccessful authentication attempts, used to evaluate the `locked_out` result.").Description,
+							Optional:    true,
+						},
+					},
+				},
+			},
+
+			"valid": schema.BoolAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that indicates whether none of the test passwords violate any of the evaluated rules.").Description,
+				Computed:    true,
+			},
+
+			"results": schema.ListNestedAttribute{
+				Description:         resultsDescription.Description,
+				MarkdownDescription: resultsDescription.MarkdownDescription,
+				Computed:            true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"valid": schema.BoolAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that indicates whether the test password does not violate any of the evaluated rules.").Description,
+							Computed:    true,
+						},
+
+						"violations": schema.ListAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The rules that the test password violates.").Description,
+							Computed:    true,
+
+							ElementType: types.StringType,
+						},
+
+						"unevaluated_rules": schema.ListAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The rules of the policy that could not be evaluated for the test password.").Description,
+							Computed:    true,
+
+							ElementType: types.StringType,
+						},
+
+						"current_password_expired": schema.BoolAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that indicates whether the user's current password has exceeded `password_age_max`.  Only set when `current_password_age_days` is set and the policy has a maximum password age.").Description,
+							Computed:    true,
+						},
+
+						"locked_out": schema.BoolAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A boolean that indicates whether the user would be locked out after `failed_attempts` unsuccessful authentication attempts.  Only set when `failed_attempts` is set and the policy has a lockout failure count.").Description,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicyEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *PasswordPolicyEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *PasswordPolicyEvaluationDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var passwordPolicy *management.PasswordPolicy
+
+	if !data.PasswordPolicyId.IsNull() {
+
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.ManagementAPIClient.PasswordPoliciesApi.ReadOnePasswordPolicy(ctx, data.EnvironmentId.ValueString(), data.PasswordPolicyId.ValueString()).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"ReadOnePasswordPolicy",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&passwordPolicy,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.PasswordPolicy.IsNull() {
+
+		var d diag.Diagnostics
+		passwordPolicy, d = data.expandPasswordPolicy(ctx)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot evaluate the test passwords. password_policy_id or password_policy must be set.",
+		)
+		return
+	}
+
+	inputs, d := data.expandTestPasswords(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]passwordPolicyEvaluationResult, 0, len(inputs))
+	for _, input := range inputs {
+		results = append(results, evaluatePasswordPolicy(passwordPolicy, input))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(results)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *PasswordPolicyEvaluationDataSourceModel) expandPasswordPolicy(ctx context.Context) (*management.PasswordPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var plan passwordPolicyEvaluationPolicyDataSourceModel
+	diags.Append(p.PasswordPolicy.As(ctx, &plan, basetypes.ObjectAsOptions{
+		UnhandledNullAsEmpty:    false,
+		UnhandledUnknownAsEmpty: false,
+	})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The inline policy has the same shape as the resource, so the resource expander builds the policy model
+	resourceModel := passwordPolicyResourceModelV1{
+		ExcludesCommonlyUsedPasswords: plan.ExcludesCommonlyUsedPasswords,
+		ExcludesProfileData:           plan.ExcludesProfileData,
+		AlphabetSequenceRule:          plan.AlphabetSequenceRule,
+		History:                       plan.History,
+		Length:                        plan.Length,
+		Lockout:                       plan.Lockout,
+		MinCharacters:                 plan.MinCharacters,
+		NumberSequenceRule:            plan.NumberSequenceRule,
+		QwertySequenceRule:            plan.QwertySequenceRule,
+		ShiftedNumberRowSequenceRule:  plan.ShiftedNumberRowSequenceRule,
+		PasswordAgeMax:                plan.PasswordAgeMax,
+		PasswordAgeMin:                plan.PasswordAgeMin,
+		MaxRepeatedCharacters:         plan.MaxRepeatedCharacters,
+		MinComplexity:                 plan.MinComplexity,
+		MinUniqueCharacters:           plan.MinUniqueCharacters,
+		NotSimilarToCurrent:           plan.NotSimilarToCurrent,
+	}
+
+	return resourceModel.expand(ctx)
+}
+
+func (p *PasswordPolicyEvaluationDataSourceModel) expandTestPasswords(ctx context.Context) ([]passwordPolicyEvaluationInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var plan []passwordPolicyEvaluationTestPasswordDataSourceModel
+	diags.Append(p.TestPasswords.ElementsAs(ctx, &plan, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	inputs := make([]passwordPolicyEvaluationInput, 0, len(plan))
+	for _, v := range plan {
+		input := passwordPolicyEvaluationInput{
+			Password:               v.Password.ValueString(),
+			CurrentPassword:        v.CurrentPassword.ValueStringPointer(),
+			CurrentPasswordAgeDays: v.CurrentPasswordAgeDays.ValueInt32Pointer(),
+			FailedAttempts:         v.FailedAttempts.ValueInt32Pointer(),
+		}
+
+		if !v.PreviousPasswords.IsNull() && !v.PreviousPasswords.IsUnknown() {
+			input.PreviousPasswords = make([]string, 0)
+			diags.Append(v.PreviousPasswords.ElementsAs(ctx, &input.PreviousPasswords, false)...)
+		}
+
+		if !v.ProfileValues.IsNull() && !v.ProfileValues.IsUnknown() {
+			input.ProfileValues = make([]string, 0)
+			diags.Append(v.ProfileValues.ElementsAs(ctx, &input.ProfileValues, false)...)
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return inputs, diags
+}
+
+func (p *PasswordPolicyEvaluationDataSourceModel) toState(results []passwordPolicyEvaluationResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if results == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	tfObjType := types.ObjectType{AttrTypes: passwordPolicyEvaluationResultsTFObjectTypes}
+
+	valid := true
+	flattenedList := []attr.Value{}
+	for _, v := range results {
+		valid = valid && len(v.Violations) == 0
+
+		violations, d := framework.StringSliceToTF(v.Violations)
+		diags.Append(d...)
+
+		unevaluatedRules, d := framework.StringSliceToTF(v.UnevaluatedRules)
+		diags.Append(d...)
+
+		objMap := map[string]attr.Value{
+			"valid":                    types.BoolValue(len(v.Violations) == 0),
+			"violations":               violations,
+			"unevaluated_rules":        unevaluatedRules,
+			"current_password_expired": types.BoolPointerValue(v.CurrentPasswordExpired),
+			"locked_out":               types.BoolPointerValue(v.LockedOut),
+		}
+
+		flattenedObj, d := types.ObjectValue(passwordPolicyEvaluationResultsTFObjectTypes, objMap)
+		diags.Append(d...)
+
+		flattenedList = append(flattenedList, flattenedObj)
+	}
+
+	p.Valid = types.BoolValue(valid)
+
+	var d diag.Diagnostics
+	p.Results, d = types.ListValue(tfObjType, flattenedList)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,322 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccPasswordPolicyEvaluationDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_password_policy_evaluation.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.PasswordPolicy_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPasswordPolicyEvaluationDataSourceConfig_ByIDFull(resourceName, name),
+				Check:  testAccPasswordPolicyEvaluationDataSourceCheck_Full(dataSourceFullName),
+			},
+		},
+	})
+}
+
+func TestAccPasswordPolicyEvaluationDataSource_InlineFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_password_policy_evaluation.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPasswordPolicyEvaluationDataSourceConfig_InlineFull(resourceName),
+				Check:  testAccPasswordPolicyEvaluationDataSourceCheck_Full(dataSourceFullName),
+			},
+		},
+	})
+}
+
+func TestAccPasswordPolicyEvaluationDataSource_InlineMinimal(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_password_policy_evaluation.%s", resourceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPasswordPolicyEvaluationDataSourceConfig_InlineMinimal(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "environment_id"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "password_policy_id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "results.0.valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "results.0.violations.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "results.0.unevaluated_rules.#", "0"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "results.0.current_password_expired"),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "results.0.locked_out"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPasswordPolicyEvaluationDataSource_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPasswordPolicyEvaluationDataSourceConfig_IDAndInline(resourceName),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccPasswordPolicyEvaluationDataSourceConfig_IDWithoutEnvironment(resourceName),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccPasswordPolicyEvaluationDataSourceCheck_Full(dataSourceFullName string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+		resource.TestCheckResourceAttr(dataSourceFullName, "valid", "false"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.#", "4"),
+
+		// Compliant password, with the user dependent rules unevaluated
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.valid", "true"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.violations.#", "0"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.unevaluated_rules.#", "4"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.unevaluated_rules.0", "excludes_commonly_used_passwords"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.unevaluated_rules.1", "excludes_profile_data"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.unevaluated_rules.2", "history"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.0.unevaluated_rules.3", "not_similar_to_current"),
+		resource.TestCheckNoResourceAttr(dataSourceFullName, "results.0.current_password_expired"),
+		resource.TestCheckNoResourceAttr(dataSourceFullName, "results.0.locked_out"),
+
+		// Too short, and missing an uppercase character
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.1.valid", "false"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.1.violations.#", "2"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.1.violations.0", "length.min"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.1.violations.1", "min_characters.alphabetical_uppercase"),
+
+		// Sequences
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.2.valid", "false"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.2.violations.#", "2"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.2.violations.0", "alphabet_sequence_rule"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.2.violations.1", "number_sequence_rule"),
+
+		// User dependent rules
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.valid", "false"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.violations.#", "4"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.violations.0", "excludes_profile_data"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.violations.1", "history"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.violations.2", "not_similar_to_current"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.violations.3", "password_age_min"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.unevaluated_rules.#", "1"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.unevaluated_rules.0", "excludes_commonly_used_passwords"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.current_password_expired", "false"),
+		resource.TestCheckResourceAttr(dataSourceFullName, "results.3.locked_out", "true"),
+	)
+}
+
+const testAccPasswordPolicyEvaluationDataSourceTestPasswords = `
+  test_passwords = [
+    {
+      password = "2FederateM0re!"
+    },
+    {
+      password = "short1!"
+    },
+    {
+      password = "Abcd1234!xyz"
+    },
+    {
+      password                  = "2FederateM0re!"
+      current_password          = "2FederateM0re?"
+      previous_passwords        = ["2FederateM0re!"]
+      profile_values            = ["2FederateM0re!"]
+      current_password_age_days = 0
+      failed_attempts           = 5
+    },
+  ]`
+
+const testAccPasswordPolicyEvaluationDataSourcePolicyRules = `
+  excludes_commonly_used_passwords = true
+  excludes_profile_data            = true
+  not_similar_to_current           = true
+
+  history = {
+    count          = 6
+    retention_days = 365
+  }
+
+  length = {
+    min = 8
+    max = 255
+  }
+
+  password_age_max = 182
+  password_age_min = 1
+
+  lockout = {
+    duration_seconds = 900
+    failure_count    = 5
+  }
+
+  min_characters = {
+    alphabetical_uppercase = 1
+    alphabetical_lowercase = 1
+    numeric                = 1
+    special_characters     = 1
+  }
+
+  alphabet_sequence_rule = {
+    max_length = 3
+  }
+
+  number_sequence_rule = {
+    max_length = 3
+  }
+
+  qwerty_sequence_rule = {
+    max_length = 3
+  }
+
+  shifted_number_row_sequence_rule = {
+    max_length = 3
+  }
+
+  max_repeated_characters = 2
+  min_complexity          = 7
+  min_unique_characters   = 5`
+
+func testAccPasswordPolicyEvaluationDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_password_policy" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+%[4]s
+}
+
+data "pingone_password_policy_evaluation" "%[2]s" {
+  environment_id     = data.pingone_environment.general_test.id
+  password_policy_id = pingone_password_policy.%[2]s.id
+%[5]s
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, testAccPasswordPolicyEvaluationDataSourcePolicyRules, testAccPasswordPolicyEvaluationDataSourceTestPasswords)
+}
+
+func testAccPasswordPolicyEvaluationDataSourceConfig_InlineFull(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_password_policy_evaluation" "%[2]s" {
+  password_policy = {
+%[3]s
+  }
+%[4]s
+}`, acctest.GenericSandboxEnvironment(), resourceName, testAccPasswordPolicyEvaluationDataSourcePolicyRules, testAccPasswordPolicyEvaluationDataSourceTestPasswords)
+}
+
+func testAccPasswordPolicyEvaluationDataSourceConfig_InlineMinimal(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_password_policy_evaluation" "%[2]s" {
+  password_policy = {
+    length = {
+      min = 8
+    }
+  }
+
+  test_passwords = [
+    {
+      password = "correcthorsebatterystaple"
+    },
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccPasswordPolicyEvaluationDataSourceConfig_IDAndInline(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_password_policy_evaluation" "%[2]s" {
+  environment_id     = data.pingone_environment.general_test.id
+  password_policy_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID
+
+  password_policy = {
+    length = {
+      min = 8
+    }
+  }
+
+  test_passwords = [
+    {
+      password = "correcthorsebatterystaple"
+    },
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
+
+func testAccPasswordPolicyEvaluationDataSourceConfig_IDWithoutEnvironment(resourceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_password_policy_evaluation" "%[2]s" {
+  password_policy_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID
+
+  test_passwords = [
+    {
+      password = "correcthorsebatterystaple"
+    },
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName)
+}
@@ -0,0 +1,249 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+// populationsWithDetailsDataSource extends the generated pingone_populations data source with the `include_details` and `items`
+// attributes.  When details are not requested, reads are delegated to the generated data source.
+type populationsWithDetailsDataSource struct {
+	populationsDataSource
+}
+
+type populationsWithDetailsDataSourceModel struct {
+	populationsDataSourceModel
+	IncludeDetails types.Bool `tfsdk:"include_details"`
+	Items          types.List `tfsdk:"items"`
+}
+
+var (
+	_ datasource.DataSource              = &populationsWithDetailsDataSource{}
+	_ datasource.DataSourceWithConfigure = &populationsWithDetailsDataSource{}
+)
+
+func NewPopulationsWithDetailsDataSource() datasource.DataSource {
+	return &populationsWithDetailsDataSource{}
+}
+
+func (r *populationsWithDetailsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	r.populationsDataSource.Schema(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The returned population attributes are taken from the pingone_population data source schema, so each item has the same shape as the singular data source
+	populationSchemaResp := datasource.SchemaResponse{}
+	(&populationDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &populationSchemaResp)
+	resp.Diagnostics.Append(populationSchemaResp.Diagnostics...)
+
+	itemAttributes, d := framework.DataSourceComputedAttributesFromDataSourceAttributes(populationSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemAttributes["environment_id"] = schema.StringAttribute{
+		CustomType:  pingonetypes.ResourceIDType{},
+		Computed:    true,
+		Description: "The ID of the environment that the population belongs to.",
+	}
+	itemAttributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "A string that specifies the name of the population.",
+	}
+	itemAttributes["population_id"] = schema.StringAttribute{
+		CustomType:  pingonetypes.ResourceIDType{},
+		Computed:    true,
+		Description: "A string that specifies the ID of the population.",
+	}
+
+	resp.Schema.Attributes["include_details"] = framework.Attr_DataSourceIncludeDetails(framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that specifies whether the full details of each population should be returned in the `items` attribute.  The details are taken from a single additional paged list of the environment's populations.",
+	))
+
+	resp.Schema.Attributes["items"] = framework.Attr_DataSourceReturnItems(framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of objects that describe the populations that have been successfully retrieved, with the same attributes as the `pingone_population` data source.  Only populated when `include_details` is set to `true`.",
+	), itemAttributes)
+}
+
+func (r *populationsWithDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data populationsWithDetailsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemsType, d := req.Config.Schema.TypeAtPath(ctx, path.Root("items"))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IncludeDetails.ValueBool() {
+		resp.Diagnostics.Append(r.readWithDetails(ctx, itemsType, &data)...)
+	} else {
+		resp.Diagnostics.Append(r.readWithoutDetails(ctx, itemsType, &data)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readWithoutDetails runs the generated data source read against the generated schema, and returns a null `items` list.
+func (r *populationsWithDetailsDataSource) readWithoutDetails(ctx context.Context, itemsType attr.Type, data *populationsWithDetailsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	baseSchemaResp := datasource.SchemaResponse{}
+	r.populationsDataSource.Schema(ctx, datasource.SchemaRequest{}, &baseSchemaResp)
+	diags.Append(baseSchemaResp.Diagnostics...)
+	if diags.HasError() {
+		return diags
+	}
+
+	baseConfig := tfsdk.State{
+		Schema: baseSchemaResp.Schema,
+	}
+	diags.Append(baseConfig.Set(ctx, &data.populationsDataSourceModel)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	baseReq := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: baseSchemaResp.Schema,
+			Raw:    baseConfig.Raw,
+		},
+	}
+	baseResp := datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: baseSchemaResp.Schema,
+		},
+	}
+
+	r.populationsDataSource.Read(ctx, baseReq, &baseResp)
+	diags.Append(baseResp.Diagnostics...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(baseResp.State.Get(ctx, &data.populationsDataSourceModel)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var d diag.Diagnostics
+	data.Items, d = framework.DataSourceItemsToTF[populationDataSourceModel](ctx, itemsType, nil)
+	diags.Append(d...)
+
+	return diags
+}
+
+// readWithDetails runs the generated data source read to resolve the populations that match the configured filter, then returns the
+// details of each of those populations from a single paged list of the environment's populations.
+func (r *populationsWithDetailsDataSource) readWithDetails(ctx context.Context, itemsType attr.Type, data *populationsWithDetailsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(r.readWithoutDetails(ctx, itemsType, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var ids []string
+	diags.Append(data.Ids.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Read API call
+	var responseData []management.Population
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := r.Client.ManagementAPIClient.PopulationsApi.ReadAllPopulations(ctx, data.EnvironmentId.ValueString()).Execute()
+
+			var initialHttpResponse *http.Response
+
+			foundPopulations := make([]management.Population, 0)
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if results, ok := pageCursor.EntityArray.Embedded.GetPopulationsOk(); ok {
+					foundPopulations = append(foundPopulations, results...)
+				}
+			}
+
+			return foundPopulations, initialHttpResponse, nil
+		},
+		"ReadAllPopulations",
+		legacysdk.DefaultCustomError,
+		nil,
+		&responseData,
+	)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Read response into the model
+	diags.Append(data.readClientResponseItems(ctx, ids, responseData, itemsType)...)
+
+	return diags
+}
+
+// readClientResponseItems sets `items` to the details of each population in ids, in the same order as ids.  Populations that are no longer
+// returned by the list call are omitted.
+func (state *populationsWithDetailsDataSourceModel) readClientResponseItems(ctx context.Context, ids []string, response []management.Population, itemsType attr.Type) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+
+	populations := make(map[string]management.Population, len(response))
+	for _, resultObj := range response {
+		if id, ok := resultObj.GetIdOk(); ok {
+			populations[*id] = resultObj
+		}
+	}
+
+	items := make([]populationDataSourceModel, 0, len(ids))
+	for _, id := range ids {
+		resultObj, ok := populations[id]
+		if !ok {
+			continue
+		}
+
+		item := populationDataSourceModel{
+			EnvironmentId: state.EnvironmentId,
+		}
+		respDiags.Append(item.readClientResponse(&resultObj)...)
+		items = append(items, item)
+	}
+	state.Items, diags = framework.DataSourceItemsToTF(ctx, itemsType, items)
+	respDiags.Append(diags...)
+
+	return respDiags
+}
@@ -38,6 +38,43 @@ func TestAccPopulationsDataSource_BySCIMFilter(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.0", verify.P1ResourceIDRegexpFullString),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.1", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPopulationsDataSource_IncludeDetails(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_populations.%s", resourceName)
+	populationResourceFullName := fmt.Sprintf("pingone_population.%s-1", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.Population_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPopulationsDataSourceConfig_IncludeDetails(resourceName, fmt.Sprintf(`name eq \"%s-1\"`, name), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.id", populationResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.population_id", populationResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.environment_id", populationResourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.name", populationResourceFullName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.description", populationResourceFullName, "description"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.0.default", "false"),
 				),
 			},
 		},
@@ -150,6 +187,29 @@ data "pingone_populations" "%[2]s" {
 `, acctest.GenericSandboxEnvironment(), resourceName, name, filter)
 }
 
+func testAccPopulationsDataSourceConfig_IncludeDetails(resourceName, filter, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_population" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s-1"
+  description    = "Test population"
+}
+
+data "pingone_populations" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  scim_filter     = "%[4]s"
+  include_details = true
+
+  depends_on = [
+    pingone_population.%[2]s-1,
+  ]
+}
+`, acctest.GenericSandboxEnvironment(), resourceName, name, filter)
+}
+
 func testAccPopulationsDataSourceConfig_ByDataFilters1(resourceName, name string) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -0,0 +1,519 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type SchemaAttributeUniquenessCheckDataSource serviceClientType
+
+type SchemaAttributeUniquenessCheckDataSourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	AttributeName types.String                 `tfsdk:"attribute_name"`
+	PopulationId  pingonetypes.ResourceIDValue `tfsdk:"population_id"`
+	CaseSensitive types.Bool                   `tfsdk:"case_sensitive"`
+	MaxUsers      types.Int64                  `tfsdk:"max_users"`
+	Unique        types.Bool                   `tfsdk:"unique"`
+	UsersScanned  types.Int64                  `tfsdk:"users_scanned"`
+	Duplicates    types.List                   `tfsdk:"duplicates"`
+}
+
+// schemaAttributeUniquenessCheckDuplicate is a value held by more than one user
+type schemaAttributeUniquenessCheckDuplicate struct {
+	Value   string
+	UserIds []string
+}
+
+var (
+	schemaAttributeUniquenessCheckDuplicatesTFObjectTypes = map[string]attr.Type{
+		"value":    types.StringType,
+		"user_ids": types.ListType{ElemType: pingonetypes.ResourceIDType{}},
+	}
+)
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &SchemaAttributeUniquenessCheckDataSource{}
+)
+
+// New Object
+func NewSchemaAttributeUniquenessCheckDataSource() datasource.DataSource {
+	return &SchemaAttributeUniquenessCheckDataSource{}
+}
+
+// Metadata
+func (r *SchemaAttributeUniquenessCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_attribute_uniqueness_check"
+}
+
+// Schema
+func (r *SchemaAttributeUniquenessCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	attributeNameDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The system name of the user schema attribute to check.  Sub-attributes of `COMPLEX` attributes are specified in the form `parent.child`, for example `name.given`.",
+	)
+
+	populationIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of a population to limit the check to.  When set, only users in the population are scanned, which is useful when checking values that are to be used as population `alternative_identifiers`.  When not set, all users in the environment are scanned.",
+	).AppendMarkdownString("Must be a valid PingOne resource ID.")
+
+	caseSensitiveDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that specifies whether values that differ only by case are treated as distinct values.",
+	).DefaultValue(false)
+
+	maxUsersDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The maximum number of users to scan.  When more users are found, the scan stops at this number of users, a warning is returned and the result only covers the users that were scanned.  When not set, all users are scanned.",
+	)
+
+	uniqueDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that indicates whether every scanned user holds a distinct value of the attribute.  Users that do not have a value of the attribute are not considered.  When `false`, applying `unique = true` to the attribute is expected to fail.",
+	)
+
+	duplicatesDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of attribute values that are held by more than one scanned user, ordered by value.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Data source to check whether existing PingOne users hold distinct values of a user schema attribute, before the attribute is set to be unique.  Users are read with the paged user list API, so the check takes one API call per page of users, including when checking a `CUSTOM` attribute.  The `max_users` parameter can be used to limit the scan in environments with many users.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(framework.SchemaAttributeDescriptionFromMarkdown(
+				"The ID of the environment that contains the users to check.",
+			)),
+
+			"attribute_name": schema.StringAttribute{
+				Description:         attributeNameDescription.Description,
+				MarkdownDescription: attributeNameDescription.MarkdownDescription,
+				Required:            true,
+
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"population_id": schema.StringAttribute{
+				Description:         populationIdDescription.Description,
+				MarkdownDescription: populationIdDescription.MarkdownDescription,
+				Optional:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+
+			"case_sensitive": schema.BoolAttribute{
+				Description:         caseSensitiveDescription.Description,
+				MarkdownDescription: caseSensitiveDescription.MarkdownDescription,
+				Optional:            true,
+			},
+
+			"max_users": schema.Int64Attribute{
+				Description:         maxUsersDescription.Description,
+				MarkdownDescription: maxUsersDescription.MarkdownDescription,
+				Optional:            true,
+
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"unique": schema.BoolAttribute{
+				Description:         uniqueDescription.Description,
+				MarkdownDescription: uniqueDescription.MarkdownDescription,
+				Computed:            true,
+			},
+
+			"users_scanned": schema.Int64Attribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The number of users that were scanned.").Description,
+				Computed:    true,
+			},
+
+			"duplicates": schema.ListNestedAttribute{
+				Description:         duplicatesDescription.Description,
+				MarkdownDescription: duplicatesDescription.MarkdownDescription,
+				Computed:            true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The duplicated attribute value.  Values that are not strings are given in JSON form.").Description,
+							Computed:    true,
+						},
+
+						"user_ids": schema.ListAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("The IDs of the users that hold the value, ordered by ID.").Description,
+							Computed:    true,
+
+							ElementType: pingonetypes.ResourceIDType{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SchemaAttributeUniquenessCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *SchemaAttributeUniquenessCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *SchemaAttributeUniquenessCheckDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentID := data.EnvironmentId.ValueString()
+	attributeName := data.AttributeName.ValueString()
+
+	// Check the attribute exists in the user schema, and whether it is a custom attribute
+	userSchema, d := fetchSchemaFromName(ctx, r.Client.ManagementAPIClient, environmentID, userSchemaName)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentName, subAttributeName, isSubAttribute := strings.Cut(attributeName, ".")
+
+	schemaAttribute, d := fetchSchemaAttributeFromName(ctx, r.Client.ManagementAPIClient, environmentID, userSchema.GetId(), parentName)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSubAttribute {
+		var subAttribute *management.SchemaAttribute
+		for _, v := range schemaAttribute.GetSubAttributes() {
+			if strings.EqualFold(v.GetName(), subAttributeName) {
+				subAttribute = &v
+				break
+			}
+		}
+
+		if subAttribute == nil {
+			resp.Diagnostics.AddError(
+				"Cannot find schema attribute from name",
+				fmt.Sprintf("The sub-attribute %s of schema attribute %s in environment %s cannot be found", subAttributeName, schemaAttribute.GetName(), environmentID),
+			)
+			return
+		}
+
+		attributeName = fmt.Sprintf("%s.%s", schemaAttribute.GetName(), subAttribute.GetName())
+		if v, ok := subAttribute.GetTypeOk(); ok && *v != management.ENUMSCHEMAATTRIBUTETYPE_STRING {
+			resp.Diagnostics.AddWarning(
+				"Attribute cannot be unique",
+				fmt.Sprintf("The sub-attribute %s is of type %s.  Only STRING attributes can be set to be unique.", attributeName, *v),
+			)
+		}
+	} else {
+		attributeName = schemaAttribute.GetName()
+		if v, ok := schemaAttribute.GetTypeOk(); ok && *v != management.ENUMSCHEMAATTRIBUTETYPE_STRING {
+			resp.Diagnostics.AddWarning(
+				"Attribute cannot be unique",
+				fmt.Sprintf("The attribute %s is of type %s.  Only STRING attributes can be set to be unique.", attributeName, *v),
+			)
+		}
+	}
+
+	// Scan the users.  Custom attribute values are returned in the user list response, so no user is read individually.
+	users, truncated, d := readAllUsersRaw(ctx, r.Client.ManagementAPIClient, environmentID, data.PopulationId.ValueString(), int(data.MaxUsers.ValueInt64()))
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"User scan limit reached",
+			fmt.Sprintf("The scan stopped after %d users as set by the max_users parameter.  The attribute %s may have duplicate values in users that were not scanned.", len(users), attributeName),
+		)
+	}
+
+	duplicates := schemaAttributeUniquenessCheckDuplicates(users, attributeName, data.CaseSensitive.ValueBool())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(environmentID, len(users), duplicates)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readAllUsersRaw returns every user of the environment, or of the population where a population ID is given, as decoded JSON objects.
+// Where maxUsers is greater than zero, paging stops once that number of users is found, and the returned boolean is true if users were left unscanned.
+func readAllUsersRaw(ctx context.Context, apiClient *management.APIClient, environmentID, populationID string, maxUsers int) ([]map[string]any, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	truncated := false
+
+	request := apiClient.UsersApi.ReadAllUsers(ctx, environmentID)
+	if populationID != "" {
+		request = request.Filter(fmt.Sprintf(`population.id eq "%s"`, populationID))
+	}
+
+	var users []map[string]any
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := request.Execute()
+
+			var initialHttpResponse *http.Response
+
+			truncated = false
+			foundUsers := make([]map[string]any, 0)
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				// The SDK user model does not carry every attribute, so the page is decoded from the raw response
+				pageUsers, err := usersFromRawPage(pageCursor.HTTPResponse)
+				if err != nil {
+					return nil, pageCursor.HTTPResponse, err
+				}
+
+				foundUsers = append(foundUsers, pageUsers...)
+
+				if maxUsers > 0 && len(foundUsers) >= maxUsers {
+					truncated = len(foundUsers) > maxUsers || pageCursor.EntityArray.HasPaginationNext()
+					foundUsers = foundUsers[:maxUsers]
+					break
+				}
+			}
+
+			return foundUsers, initialHttpResponse, nil
+		},
+		"ReadAllUsers",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&users,
+	)...)
+
+	return users, truncated, diags
+}
+
+func usersFromRawPage(httpResponse *http.Response) ([]map[string]any, error) {
+	if httpResponse == nil || httpResponse.Body == nil {
+		return nil, fmt.Errorf("the user list API response is nil")
+	}
+
+	bodyBytes, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	httpResponse.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("the user list API response body cannot be read: %w", err)
+	}
+
+	var page struct {
+		Embedded struct {
+			Users []map[string]any `json:"users"`
+		} `json:"_embedded"`
+	}
+	if err := json.Unmarshal(bodyBytes, &page); err != nil {
+		return nil, fmt.Errorf("the user list API response body cannot be parsed as JSON: %w", err)
+	}
+
+	return page.Embedded.Users, nil
+}
+
+// schemaAttributeUniquenessCheckDuplicates groups the users by their values of the attribute and returns the values held by more than one user, ordered by value.
+func schemaAttributeUniquenessCheckDuplicates(users []map[string]any, attributeName string, caseSensitive bool) []schemaAttributeUniquenessCheckDuplicate {
+	type valueUsers struct {
+		value   string
+		userIDs []string
+	}
+
+	valuesByKey := make(map[string]*valueUsers)
+
+	for _, user := range users {
+		userID, _ := user["id"].(string)
+
+		// A multi-valued attribute that holds the same value twice does not make the user a duplicate of itself
+		seen := make(map[string]bool)
+
+		for _, value := range userAttributeValues(user, attributeName) {
+			key := value
+			if !caseSensitive {
+				key = strings.ToLower(value)
+			}
+
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			if _, ok := valuesByKey[key]; !ok {
+				valuesByKey[key] = &valueUsers{value: value}
+			}
+
+			valuesByKey[key].userIDs = append(valuesByKey[key].userIDs, userID)
+		}
+	}
+
+	duplicates := make([]schemaAttributeUniquenessCheckDuplicate, 0)
+	for _, v := range valuesByKey {
+		if len(v.userIDs) < 2 {
+			continue
+		}
+
+		sort.Strings(v.userIDs)
+
+		duplicates = append(duplicates, schemaAttributeUniquenessCheckDuplicate{
+			Value:   v.value,
+			UserIds: v.userIDs,
+		})
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].Value < duplicates[j].Value
+	})
+
+	return duplicates
+}
+
+// userAttributeValues returns the non-empty values of an attribute of a user, following `parent.child` paths and expanding multi-valued attributes.
+func userAttributeValues(user map[string]any, attributeName string) []string {
+	var value any = user
+	for _, segment := range strings.Split(attributeName, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = nil
+		for k, v := range object {
+			if strings.EqualFold(k, segment) {
+				value = v
+				break
+			}
+		}
+	}
+
+	var items []any
+	if v, ok := value.([]any); ok {
+		items = v
+	} else {
+		items = []any{value}
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case nil:
+			continue
+		case string:
+			if v != "" {
+				values = append(values, v)
+			}
+		default:
+			if jsonBytes, err := json.Marshal(v); err == nil {
+				values = append(values, string(jsonBytes))
+			}
+		}
+	}
+
+	return values
+}
+
+func (p *SchemaAttributeUniquenessCheckDataSourceModel) toState(environmentID string, usersScanned int, duplicates []schemaAttributeUniquenessCheckDuplicate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if environmentID == "" || duplicates == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if p.Id.IsNull() {
+		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+	}
+
+	p.EnvironmentId = framework.PingOneResourceIDToTF(environmentID)
+	p.Unique = types.BoolValue(len(duplicates) == 0)
+	p.UsersScanned = framework.Int64ToTF(int64(usersScanned))
+
+	tfObjType := types.ObjectType{AttrTypes: schemaAttributeUniquenessCheckDuplicatesTFObjectTypes}
+
+	flattenedList := []attr.Value{}
+	for _, v := range duplicates {
+		objMap := map[string]attr.Value{
+			"value":    framework.StringToTF(v.Value),
+			"user_ids": framework.PingOneResourceIDListToTF(v.UserIds),
+		}
+
+		flattenedObj, d := types.ObjectValue(schemaAttributeUniquenessCheckDuplicatesTFObjectTypes, objMap)
+		diags.Append(d...)
+
+		flattenedList = append(flattenedList, flattenedObj)
+	}
+
+	var d diag.Diagnostics
+	p.Duplicates, d = types.ListValue(tfObjType, flattenedList)
+	diags.Append(d...)
+
+	return diags
+}
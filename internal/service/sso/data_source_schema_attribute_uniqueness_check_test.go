@@ -0,0 +1,370 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccSchemaAttributeUniquenessCheckDataSource_Population(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_schema_attribute_uniqueness_check.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.User_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_Population(resourceName, name, "email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(dataSourceFullName, "unique", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "users_scanned", "3"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.0.value", "noreply@pingidentity.com"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.0.user_ids.#", "2"),
+					resource.TestMatchResourceAttr(dataSourceFullName, "duplicates.0.user_ids.0", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(dataSourceFullName, "duplicates.0.user_ids.1", verify.P1ResourceIDRegexpFullString),
+				),
+			},
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_MaxUsers(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("%s-max", dataSourceFullName), "unique", "true"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("%s-max", dataSourceFullName), "users_scanned", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("%s-max", dataSourceFullName), "duplicates.#", "0"),
+				),
+			},
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_Population(resourceName, name, "username"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "unique", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "users_scanned", "3"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.#", "0"),
+				),
+			},
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_Population(resourceName, name, "name.given"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "unique", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.0.user_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchemaAttributeUniquenessCheckDataSource_CaseSensitive(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_schema_attribute_uniqueness_check.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.User_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_CaseSensitive(resourceName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "unique", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.0.user_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_CaseSensitive(resourceName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "unique", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchemaAttributeUniquenessCheckDataSource_CustomAttribute(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_schema_attribute_uniqueness_check.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.User_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaAttributeUniquenessCheckDataSourceConfig_CustomAttribute(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "unique", "false"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "users_scanned", "3"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.0.value", "EMP-0001"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "duplicates.0.user_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchemaAttributeUniquenessCheckDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSchemaAttributeUniquenessCheckDataSourceConfig_NotFound(resourceName, "doesnotexist"),
+				ExpectError: regexp.MustCompile("Cannot find schema attribute from name"),
+			},
+			{
+				Config:      testAccSchemaAttributeUniquenessCheckDataSourceConfig_NotFound(resourceName, "name.doesnotexist"),
+				ExpectError: regexp.MustCompile("Cannot find schema attribute from name"),
+			},
+		},
+	})
+}
+
+func testAccSchemaAttributeUniquenessCheckDataSourceConfig_Population(resourceName, name, attributeName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-1"
+  email         = "noreply@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  name = {
+    given  = "%[3]s"
+    family = "One"
+  }
+}
+
+resource "pingone_user" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-2"
+  email         = "noreply@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  name = {
+    given  = "%[3]s"
+    family = "Two"
+  }
+}
+
+resource "pingone_user" "%[2]s-3" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-3"
+  email         = "%[3]s-3@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  name = {
+    given  = "%[3]s"
+    family = "Three"
+  }
+}
+
+data "pingone_schema_attribute_uniqueness_check" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  attribute_name = "%[4]s"
+
+  depends_on = [
+    pingone_user.%[2]s-1,
+    pingone_user.%[2]s-2,
+    pingone_user.%[2]s-3,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, attributeName)
+}
+
+func testAccSchemaAttributeUniquenessCheckDataSourceConfig_MaxUsers(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_schema_attribute_uniqueness_check" "%[2]s-max" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  attribute_name = "email"
+  max_users      = 1
+
+  depends_on = [
+    pingone_user.%[2]s-1,
+    pingone_user.%[2]s-2,
+    pingone_user.%[2]s-3,
+  ]
+}`, testAccSchemaAttributeUniquenessCheckDataSourceConfig_Population(resourceName, name, "email"), resourceName)
+}
+
+func testAccSchemaAttributeUniquenessCheckDataSourceConfig_CaseSensitive(resourceName, name string, caseSensitive bool) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-1"
+  email         = "%[3]s@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}
+
+resource "pingone_user" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-2"
+  email         = upper("%[3]s@pingidentity.com")
+  population_id = pingone_population.%[2]s.id
+}
+
+data "pingone_schema_attribute_uniqueness_check" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  attribute_name = "email"
+  case_sensitive = %[4]t
+
+  depends_on = [
+    pingone_user.%[2]s-1,
+    pingone_user.%[2]s-2,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, caseSensitive)
+}
+
+func testAccSchemaAttributeUniquenessCheckDataSourceConfig_CustomAttribute(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_schema_attribute" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+
+  type    = "STRING"
+  enabled = true
+}
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-1"
+  email         = "noreply@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  custom_attributes = jsonencode({
+    (pingone_schema_attribute.%[2]s.name) = "EMP-0001"
+  })
+}
+
+resource "pingone_user" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-2"
+  email         = "noreply@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  custom_attributes = jsonencode({
+    (pingone_schema_attribute.%[2]s.name) = "EMP-0001"
+  })
+}
+
+resource "pingone_user" "%[2]s-3" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-3"
+  email         = "noreply@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  custom_attributes = jsonencode({
+    (pingone_schema_attribute.%[2]s.name) = "EMP-0002"
+  })
+}
+
+data "pingone_schema_attribute_uniqueness_check" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  attribute_name = pingone_schema_attribute.%[2]s.name
+
+  depends_on = [
+    pingone_user.%[2]s-1,
+    pingone_user.%[2]s-2,
+    pingone_user.%[2]s-3,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccSchemaAttributeUniquenessCheckDataSourceConfig_NotFound(resourceName, attributeName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_schema_attribute_uniqueness_check" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  attribute_name = "%[3]s"
+}`, acctest.GenericSandboxEnvironment(), resourceName, attributeName)
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -39,6 +40,7 @@ type UserDataSourceModel struct {
 	PopulationId      pingonetypes.ResourceIDValue `tfsdk:"population_id"`
 	Account           types.Object                 `tfsdk:"account"`
 	Address           types.Object                 `tfsdk:"address"`
+	CustomAttributes  jsontypes.Normalized         `tfsdk:"custom_attributes"`
 	ExternalId        types.String                 `tfsdk:"external_id"`
 	IdentityProvider  types.Object                 `tfsdk:"identity_provider"`
 	Lifecycle         types.Object                 `tfsdk:"user_lifecycle"`
@@ -359,6 +361,15 @@ func (r *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				},
 			},
 
+			"custom_attributes": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown(
+					"A JSON string that specifies the values of the user's custom attributes, keyed by attribute name.  Only enabled custom attributes of the environment's `User` schema are returned.",
+				).Description,
+				Computed: true,
+
+				CustomType: jsontypes.NormalizedType{},
+			},
+
 			"external_id": schema.StringAttribute{
 				Description: framework.SchemaAttributeDescriptionFromMarkdown(
 					"A string that specifies an identifier for the user resource as defined by the provisioning client. The external id attribute simplifies the correlation of the user in PingOne with the user's account in another system of record. The platform does not use this attribute directly in any way, but it is used by Ping Identity's Data Sync product.",
@@ -664,6 +675,8 @@ func (r *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	// The page response the user is found in is retained, as it carries the custom attribute values that the SDK user model does not
+	var userPageHttpResponse *http.Response
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
@@ -684,15 +697,10 @@ func (r *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				if users, ok := pageCursor.EntityArray.Embedded.GetUsersOk(); ok {
 					for _, u := range users {
 
-						if !data.Username.IsNull() && strings.EqualFold(u.GetUsername(), data.Username.ValueString()) {
-							return &u, pageCursor.HTTPResponse, nil
-						}
-
-						if !data.UserId.IsNull() && strings.EqualFold(u.GetId(), data.UserId.ValueString()) {
-							return &u, pageCursor.HTTPResponse, nil
-						}
-
-						if !data.Email.IsNull() && strings.EqualFold(u.GetEmail(), data.Email.ValueString()) {
+						if (!data.Username.IsNull() && strings.EqualFold(u.GetUsername(), data.Username.ValueString())) ||
+							(!data.UserId.IsNull() && strings.EqualFold(u.GetId(), data.UserId.ValueString())) ||
+							(!data.Email.IsNull() && strings.EqualFold(u.GetEmail(), data.Email.ValueString())) {
+							userPageHttpResponse = pageCursor.HTTPResponse
 							return &u, pageCursor.HTTPResponse, nil
 						}
 					}
@@ -740,6 +748,24 @@ func (r *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(data.toState(user, responseEnabled)...)
+
+	schemaAttributes, d := fetchUserCustomSchemaAttributes(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString())
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customAttributes, d := userCustomAttributesFromListHTTPResponse(userPageHttpResponse, schemaAttributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.CustomAttributes = customAttributes[user.GetId()]
+	if data.CustomAttributes.IsNull() {
+		data.CustomAttributes = jsontypes.NewNormalizedValue("{}")
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
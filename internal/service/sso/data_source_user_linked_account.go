@@ -0,0 +1,265 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// Types
+type UserLinkedAccountDataSource serviceClientType
+
+type UserLinkedAccountDataSourceModel struct {
+	Id                   pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId        pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	UserId               pingonetypes.ResourceIDValue `tfsdk:"user_id"`
+	LinkedAccountId      pingonetypes.ResourceIDValue `tfsdk:"linked_account_id"`
+	IdentityProviderId   pingonetypes.ResourceIDValue `tfsdk:"identity_provider_id"`
+	IdentityProviderType types.String                 `tfsdk:"identity_provider_type"`
+	ExternalId           types.String                 `tfsdk:"external_id"`
+	CreatedAt            timetypes.RFC3339            `tfsdk:"created_at"`
+	UpdatedAt            timetypes.RFC3339            `tfsdk:"updated_at"`
+}
+
+// Framework interfaces
+var (
+	_ datasource.DataSource = &UserLinkedAccountDataSource{}
+)
+
+// New Object
+func NewUserLinkedAccountDataSource() datasource.DataSource {
+	return &UserLinkedAccountDataSource{}
+}
+
+// Metadata
+func (r *UserLinkedAccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_linked_account"
+}
+
+// Schema
+func (r *UserLinkedAccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	linkedAccountIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the ID of the linked account to retrieve configuration for.  Must be a valid PingOne resource ID.",
+	).ExactlyOneOf([]string{"linked_account_id", "identity_provider_id"})
+
+	identityProviderIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the ID of the external identity provider to retrieve the user's linked account for.  Must be a valid PingOne resource ID.",
+	).ExactlyOneOf([]string{"linked_account_id", "identity_provider_id"})
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Datasource to retrieve a PingOne user's linked account in an external identity provider, by linked account ID or by identity provider ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that contains the user."),
+			),
+
+			"user_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the user to retrieve the linked account for."),
+			),
+
+			"linked_account_id": schema.StringAttribute{
+				Description:         linkedAccountIdDescription.Description,
+				MarkdownDescription: linkedAccountIdDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("identity_provider_id")),
+				},
+			},
+
+			"identity_provider_id": schema.StringAttribute{
+				Description:         identityProviderIdDescription.Description,
+				MarkdownDescription: identityProviderIdDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("linked_account_id")),
+				},
+			},
+
+			"identity_provider_type": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The type of the external identity provider that holds the linked account.").Description,
+				Computed:    true,
+			},
+
+			"external_id": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the user in the external identity provider.").Description,
+				Computed:    true,
+			},
+
+			"created_at": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The time the linked account was created.").Description,
+				Computed:    true,
+
+				CustomType: timetypes.RFC3339Type{},
+			},
+
+			"updated_at": schema.StringAttribute{
+				Description: framework.SchemaAttributeDescriptionFromMarkdown("The time the linked account was last updated.").Description,
+				Computed:    true,
+
+				CustomType: timetypes.RFC3339Type{},
+			},
+		},
+	}
+}
+
+func (r *UserLinkedAccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *UserLinkedAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UserLinkedAccountDataSourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var linkedAccount *userLinkedAccount
+
+	if !data.LinkedAccountId.IsNull() {
+
+		// Run the API call
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := readUserLinkedAccount(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.UserId.ValueString(), data.LinkedAccountId.ValueString())
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"EnvironmentsEnvironmentIDUsersUserIDLinkedAccountsLinkedAccountIDGet",
+			legacysdk.CustomErrorResourceNotFoundWarning,
+			sdk.DefaultCreateReadRetryable,
+			&linkedAccount,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+	} else if !data.IdentityProviderId.IsNull() {
+
+		// Run the API call
+		var linkedAccounts []userLinkedAccount
+		resp.Diagnostics.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := readAllUserLinkedAccounts(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.UserId.ValueString())
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+			},
+			"EnvironmentsEnvironmentIDUsersUserIDLinkedAccountsGet",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&linkedAccounts,
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i := range linkedAccounts {
+			if linkedAccounts[i].IdentityProvider.Id == data.IdentityProviderId.ValueString() {
+				linkedAccount = &linkedAccounts[i]
+				break
+			}
+		}
+
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing parameter",
+			"Cannot find the requested linked account. linked_account_id or identity_provider_id must be set.",
+		)
+		return
+	}
+
+	if linkedAccount == nil {
+		resp.Diagnostics.AddError(
+			"Linked account not found",
+			fmt.Sprintf("The linked account with the specified linked_account_id or identity_provider_id cannot be found for user %s in environment %s.", data.UserId.String(), data.EnvironmentId.String()),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(linkedAccount)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *UserLinkedAccountDataSourceModel) toState(apiObject *userLinkedAccount) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.LinkedAccountId = framework.PingOneResourceIDToTF(apiObject.Id)
+	p.IdentityProviderId = framework.PingOneResourceIDToTF(apiObject.IdentityProvider.Id)
+	p.IdentityProviderType = framework.StringOkToTF(apiObject.IdentityProvider.Type, apiObject.IdentityProvider.Type != nil)
+	p.ExternalId = framework.StringToTF(apiObject.ExternalId)
+	p.CreatedAt = framework.TimeOkToTF(apiObject.CreatedAt, apiObject.CreatedAt != nil)
+	p.UpdatedAt = framework.TimeOkToTF(apiObject.UpdatedAt, apiObject.UpdatedAt != nil)
+
+	return diags
+}
@@ -0,0 +1,162 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+)
+
+func TestAccUserLinkedAccountDataSource_ByIDFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_linked_account.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserLinkedAccount_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserLinkedAccountDataSourceConfig_ByIDFull(resourceName, name),
+				Check:  testAccUserLinkedAccountDataSourceCheck(resourceFullName, dataSourceFullName),
+			},
+		},
+	})
+}
+
+func TestAccUserLinkedAccountDataSource_ByIdentityProviderFull(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_linked_account.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserLinkedAccount_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserLinkedAccountDataSourceConfig_ByIdentityProviderFull(resourceName, name),
+				Check:  testAccUserLinkedAccountDataSourceCheck(resourceFullName, dataSourceFullName),
+			},
+		},
+	})
+}
+
+func TestAccUserLinkedAccountDataSource_NotFound(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserLinkedAccount_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserLinkedAccountDataSourceConfig_NotFoundByID(resourceName, name),
+				ExpectError: regexp.MustCompile(`Linked account not found`),
+			},
+			{
+				Config:      testAccUserLinkedAccountDataSourceConfig_NotFoundByIdentityProvider(resourceName, name),
+				ExpectError: regexp.MustCompile(`Linked account not found`),
+			},
+		},
+	})
+}
+
+func testAccUserLinkedAccountDataSourceCheck(resourceFullName, dataSourceFullName string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "id", resourceFullName, "id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "environment_id", resourceFullName, "environment_id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "user_id", resourceFullName, "user_id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "linked_account_id", resourceFullName, "id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "identity_provider_id", resourceFullName, "identity_provider_id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "identity_provider_type", resourceFullName, "identity_provider_type"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "external_id", resourceFullName, "external_id"),
+		resource.TestCheckResourceAttrPair(dataSourceFullName, "created_at", resourceFullName, "created_at"),
+		resource.TestCheckResourceAttrSet(dataSourceFullName, "updated_at"),
+	)
+}
+
+func testAccUserLinkedAccountDataSourceConfig_ByIDFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_user_linked_account" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  linked_account_id = pingone_user_linked_account.%[2]s.id
+}`, testAccUserLinkedAccountConfig_Full(resourceName, name), resourceName)
+}
+
+func testAccUserLinkedAccountDataSourceConfig_ByIdentityProviderFull(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_user_linked_account" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  identity_provider_id = pingone_identity_provider.%[2]s.id
+
+  depends_on = [
+    pingone_user_linked_account.%[2]s,
+  ]
+}`, testAccUserLinkedAccountConfig_Full(resourceName, name), resourceName)
+}
+
+func testAccUserLinkedAccountDataSourceConfig_NotFoundByID(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_user_linked_account" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  linked_account_id = "9c052a8a-14be-44e4-8f07-2662569994ce" // dummy ID that conforms to UUID v4
+}`, testAccUserLinkedAccountConfig_Base(resourceName, name), resourceName)
+}
+
+func testAccUserLinkedAccountDataSourceConfig_NotFoundByIdentityProvider(resourceName, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_user_linked_account" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  user_id        = pingone_user.%[2]s.id
+
+  identity_provider_id = pingone_identity_provider.%[2]s.id
+}`, testAccUserLinkedAccountConfig_Base(resourceName, name), resourceName)
+}
@@ -186,6 +186,38 @@ func TestAccUserDataSource_ByIDFull(t *testing.T) {
 	})
 }
 
+func TestAccUserDataSource_CustomAttributes(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user.%s", resourceName)
+	dataSourceFullName := fmt.Sprintf("data.%s", resourceFullName)
+
+	name := resourceName
+
+	customAttributes := fmt.Sprintf(`{"%[1]sCode":"ABC-1234","%[1]sPreferences":{"theme":"dark"},"%[1]sTags":["blue","green"],"%[1]sTier":"gold"}`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig_CustomAttributes(resourceName, name, customAttributes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(dataSourceFullName, "custom_attributes", customAttributes),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "custom_attributes", resourceFullName, "custom_attributes"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccUserDataSource_NotFound(t *testing.T) {
 	t.Parallel()
 
@@ -267,6 +299,17 @@ data "pingone_user" "%[2]s" {
 }`, testAccUserConfig_Full(resourceName, name), resourceName, name)
 }
 
+func testAccUserDataSourceConfig_CustomAttributes(resourceName, name, customAttributes string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+data "pingone_user" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  user_id = pingone_user.%[2]s.id
+}`, testAccUserConfig_CustomAttributes(resourceName, name, customAttributes), resourceName, name)
+}
+
 func testAccUserDataSourceConfig_NotFoundByName(resourceName string) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -5,12 +5,16 @@ package sso
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/patrickcping/pingone-go-sdk-v2/management"
@@ -25,11 +29,13 @@ import (
 type UsersDataSource serviceClientType
 
 type UsersDataSourceModel struct {
-	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
-	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
-	ScimFilter    types.String                 `tfsdk:"scim_filter"`
-	DataFilters   types.List                   `tfsdk:"data_filters"`
-	Ids           types.List                   `tfsdk:"ids"`
+	EnvironmentId  pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	Id             pingonetypes.ResourceIDValue `tfsdk:"id"`
+	ScimFilter     types.String                 `tfsdk:"scim_filter"`
+	DataFilters    types.List                   `tfsdk:"data_filters"`
+	Ids            types.List                   `tfsdk:"ids"`
+	IncludeDetails types.Bool                   `tfsdk:"include_details"`
+	Items          types.List                   `tfsdk:"items"`
 }
 
 // Framework interfaces
@@ -82,6 +88,48 @@ func (r *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 		"memberOfGroups.id",
 	}
 
+	// The returned user attributes are taken from the pingone_user data source schema, so each item has the same shape as the singular data source
+	userSchemaResp := datasource.SchemaResponse{}
+	(&UserDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &userSchemaResp)
+	resp.Diagnostics.Append(userSchemaResp.Diagnostics...)
+
+	itemAttributes, d := framework.DataSourceComputedAttributesFromDataSourceAttributes(userSchemaResp.Schema.Attributes)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemAttributes["environment_id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that the user belongs to.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	itemAttributes["user_id"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The ID of the user.").Description,
+		Computed:    true,
+
+		CustomType: pingonetypes.ResourceIDType{},
+	}
+
+	itemAttributes["username"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The username of the user.").Description,
+		Computed:    true,
+	}
+
+	itemAttributes["email"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("The email address of the user.").Description,
+		Computed:    true,
+	}
+
+	itemAttributes["custom_attributes"] = schema.StringAttribute{
+		Description: framework.SchemaAttributeDescriptionFromMarkdown("A JSON string that specifies the values of the user's custom attributes, keyed by attribute name.  Only enabled custom attributes of the environment's `User` schema are returned.").Description,
+		Computed:    true,
+
+		CustomType: jsontypes.NormalizedType{},
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Datasource to retrieve multiple PingOne user IDs selected by a SCIM filter.",
@@ -110,6 +158,14 @@ func (r *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			"ids": framework.Attr_DataSourceReturnIDs(framework.SchemaAttributeDescriptionFromMarkdown(
 				"The list of resulting IDs of users that have been successfully retrieved and filtered.",
 			)),
+
+			"include_details": framework.Attr_DataSourceIncludeDetails(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A boolean that specifies whether the full details of each user should be returned in the `items` attribute.  The details are taken from the same paged list response used to populate `ids`, so no additional API calls are made.",
+			)),
+
+			"items": framework.Attr_DataSourceReturnItems(framework.SchemaAttributeDescriptionFromMarkdown(
+				"A list of objects that describe the users that have been successfully retrieved and filtered, with the same attributes as the `pingone_user` data source.  Only populated when `include_details` is set to `true`.",
+			), itemAttributes),
 		},
 	}
 }
@@ -206,7 +262,20 @@ func (r *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	var userIDs []string
+	// Custom attribute values are not carried by the SDK user model, so they are read from the raw list page responses
+	var schemaAttributes map[string]management.SchemaAttribute
+	var customAttributes map[string]jsontypes.Normalized
+	var customAttributesDiags diag.Diagnostics
+	if data.IncludeDetails.ValueBool() {
+		var d diag.Diagnostics
+		schemaAttributes, d = fetchUserCustomSchemaAttributes(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString())
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var users []management.User
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
@@ -215,7 +284,9 @@ func (r *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 			var initialHttpResponse *http.Response
 
-			foundIDs := make([]string, 0)
+			foundUsers := make([]management.User, 0)
+			customAttributes = make(map[string]jsontypes.Normalized)
+			customAttributesDiags = nil
 
 			for pageCursor, err := range pagedIterator {
 				if err != nil {
@@ -227,32 +298,43 @@ func (r *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				}
 
 				if pageCursor.EntityArray.Embedded != nil && pageCursor.EntityArray.Embedded.Users != nil {
-					for _, item := range pageCursor.EntityArray.Embedded.GetUsers() {
-						foundIDs = append(foundIDs, item.GetId())
+					foundUsers = append(foundUsers, pageCursor.EntityArray.Embedded.GetUsers()...)
+
+					if schemaAttributes != nil {
+						pageCustomAttributes, d := userCustomAttributesFromListHTTPResponse(pageCursor.HTTPResponse, schemaAttributes)
+						customAttributesDiags.Append(d...)
+						maps.Copy(customAttributes, pageCustomAttributes)
 					}
 				}
 			}
 
-			return foundIDs, initialHttpResponse, nil
+			return foundUsers, initialHttpResponse, nil
 		},
 		"ReadAllUsers",
 		legacysdk.DefaultCustomError,
 		sdk.DefaultCreateReadRetryable,
-		&userIDs,
+		&users,
 	)...)
+	resp.Diagnostics.Append(customAttributesDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemsType, d := req.Config.Schema.TypeAtPath(ctx, path.Root("items"))
+	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(data.toState(data.EnvironmentId.ValueString(), userIDs)...)
+	resp.Diagnostics.Append(data.toState(ctx, data.EnvironmentId.ValueString(), users, customAttributes, itemsType)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (p *UsersDataSourceModel) toState(environmentID string, userIDs []string) diag.Diagnostics {
+func (p *UsersDataSourceModel) toState(ctx context.Context, environmentID string, users []management.User, customAttributes map[string]jsontypes.Normalized, itemsType attr.Type) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if userIDs == nil || environmentID == "" {
+	if users == nil || environmentID == "" {
 		diags.AddError(
 			"Data object missing",
 			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
@@ -267,9 +349,39 @@ func (p *UsersDataSourceModel) toState(environmentID string, userIDs []string) d
 		p.Id = framework.PingOneResourceIDToTF(uuid.New().String())
 	}
 
+	userIDs := make([]string, 0, len(users))
+	var items []UserDataSourceModel
+
+	if p.IncludeDetails.ValueBool() {
+		items = make([]UserDataSourceModel, 0, len(users))
+	}
+
+	for _, user := range users {
+		userIDs = append(userIDs, user.GetId())
+
+		if items != nil {
+			// The list response carries the enabled state on the user object itself
+			item := UserDataSourceModel{}
+			diags.Append(item.toState(&user, &management.UserEnabled{Enabled: user.Enabled})...)
+			if diags.HasError() {
+				return diags
+			}
+
+			item.CustomAttributes = customAttributes[user.GetId()]
+			if item.CustomAttributes.IsNull() {
+				item.CustomAttributes = jsontypes.NewNormalizedValue("{}")
+			}
+
+			items = append(items, item)
+		}
+	}
+
 	p.EnvironmentId = framework.PingOneResourceIDToTF(environmentID)
 	p.Ids, d = framework.StringSliceToTF(userIDs)
 	diags.Append(d...)
 
+	p.Items, d = framework.DataSourceItemsToTF(ctx, itemsType, items)
+	diags.Append(d...)
+
 	return diags
 }
@@ -38,6 +38,45 @@ func TestAccUsersDataSource_BySCIMFilter(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "2"),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.0", verify.P1ResourceIDRegexpFullString),
 					resource.TestMatchResourceAttr(dataSourceFullName, "ids.1", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckNoResourceAttr(dataSourceFullName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUsersDataSource_IncludeDetails(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	dataSourceFullName := fmt.Sprintf("data.pingone_users.%s", resourceName)
+	userResourceFullName := fmt.Sprintf("pingone_user.%s-1", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.User_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_IncludeDetails(resourceName, fmt.Sprintf(`username eq \"%s-1\"`, name), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFullName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.id", userResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.user_id", userResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.environment_id", userResourceFullName, "environment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.username", userResourceFullName, "username"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.email", userResourceFullName, "email"),
+					resource.TestCheckResourceAttrPair(dataSourceFullName, "items.0.population_id", userResourceFullName, "population_id"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.0.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceFullName, "items.0.custom_attributes", "{}"),
 				),
 			},
 		},
@@ -165,6 +204,36 @@ data "pingone_users" "%[2]s" {
 }`, acctest.GenericSandboxEnvironment(), resourceName, name, filter)
 }
 
+func testAccUsersDataSourceConfig_IncludeDetails(resourceName, filter, name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-1"
+  email         = "noreply@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}
+
+data "pingone_users" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  scim_filter     = "%[4]s"
+  include_details = true
+
+  depends_on = [
+    pingone_user.%[2]s-1,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, filter)
+}
+
 func testAccUsersDataSourceConfig_ByDataFilters1(resourceName, name string) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -0,0 +1,345 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+)
+
+const (
+	// The special characters counted by the min_characters.special_characters rule
+	passwordPolicySpecialCharacters = "~!@#$%^&*()-_=+[]{}\\|;:,.<>/?"
+
+	// The attack rate used to approximate the password haystack search space exhaustion time for the min_complexity rule, in guesses per second.  This is an online attack rate, as password guesses against PingOne are made through the authentication API.
+	passwordPolicyComplexityGuessesPerSecond = 1000
+
+	// The Levenshtein distance below which a proposed password is treated as too similar to the current password.  The service does not publish its threshold, so this is an approximation.
+	passwordPolicyNotSimilarToCurrentMinDistance = 3
+)
+
+var (
+	passwordPolicyAlphabetSequences         = []string{"abcdefghijklmnopqrstuvwxyz"}
+	passwordPolicyNumberSequences           = []string{"0123456789"}
+	passwordPolicyQwertySequences           = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+	passwordPolicyShiftedNumberRowSequences = []string{"!@#$%^&*()"}
+)
+
+// passwordPolicyEvaluationInput is a test password, with the context needed to evaluate the rules that depend on the user
+type passwordPolicyEvaluationInput struct {
+	Password               string
+	CurrentPassword        *string
+	PreviousPasswords      []string
+	ProfileValues          []string
+	CurrentPasswordAgeDays *int32
+	FailedAttempts         *int32
+}
+
+// passwordPolicyEvaluationResult is the outcome of evaluating a test password against a password policy
+type passwordPolicyEvaluationResult struct {
+	Violations             []string
+	UnevaluatedRules       []string
+	CurrentPasswordExpired *bool
+	LockedOut              *bool
+}
+
+// evaluatePasswordPolicy evaluates a test password against the rules of a password policy.  Violated and unevaluated rules are identified by the name of the policy attribute that defines them, and are returned in a stable order.
+func evaluatePasswordPolicy(policy *management.PasswordPolicy, input passwordPolicyEvaluationInput) passwordPolicyEvaluationResult {
+	result := passwordPolicyEvaluationResult{
+		Violations:       make([]string, 0),
+		UnevaluatedRules: make([]string, 0),
+	}
+
+	password := []rune(input.Password)
+
+	if v, ok := policy.GetLengthOk(); ok {
+		if minValue, ok := v.GetMinOk(); ok && len(password) < int(*minValue) {
+			result.Violations = append(result.Violations, "length.min")
+		}
+
+		if maxValue, ok := v.GetMaxOk(); ok && len(password) > int(*maxValue) {
+			result.Violations = append(result.Violations, "length.max")
+		}
+	}
+
+	if v, ok := policy.GetMinCharactersOk(); ok {
+		if minValue, ok := v.GetABCDEFGHIJKLMNOPQRSTUVWXYZOk(); ok && countRunesInRange(password, isASCIIUpper) < int(*minValue) {
+			result.Violations = append(result.Violations, "min_characters.alphabetical_uppercase")
+		}
+
+		if minValue, ok := v.GetAbcdefghijklmnopqrstuvwxyzOk(); ok && countRunesInRange(password, isASCIILower) < int(*minValue) {
+			result.Violations = append(result.Violations, "min_characters.alphabetical_lowercase")
+		}
+
+		if minValue, ok := v.GetVar0123456789Ok(); ok && countRunesInRange(password, isASCIIDigit) < int(*minValue) {
+			result.Violations = append(result.Violations, "min_characters.numeric")
+		}
+
+		if minValue, ok := v.GetSpecialCharOk(); ok && countRunesInRange(password, isPasswordPolicySpecialCharacter) < int(*minValue) {
+			result.Violations = append(result.Violations, "min_characters.special_characters")
+		}
+	}
+
+	if v, ok := policy.GetMaxRepeatedCharactersOk(); ok && longestRepeatedRun(password) > int(*v) {
+		result.Violations = append(result.Violations, "max_repeated_characters")
+	}
+
+	if v, ok := policy.GetMinUniqueCharactersOk(); ok && countUniqueRunes(password) < int(*v) {
+		result.Violations = append(result.Violations, "min_unique_characters")
+	}
+
+	if v, ok := policy.GetMinComplexityOk(); ok && passwordHaystackDays(password) < float64(*v) {
+		result.Violations = append(result.Violations, "min_complexity")
+	}
+
+	if v, ok := policy.GetAlphabetSequenceRuleOk(); ok {
+		if maxValue, ok := v.GetMaxLengthOk(); ok && longestSequenceRun(password, passwordPolicyAlphabetSequences) > int(*maxValue) {
+			result.Violations = append(result.Violations, "alphabet_sequence_rule")
+		}
+	}
+
+	if v, ok := policy.GetNumberSequenceRuleOk(); ok {
+		if maxValue, ok := v.GetMaxLengthOk(); ok && longestSequenceRun(password, passwordPolicyNumberSequences) > int(*maxValue) {
+			result.Violations = append(result.Violations, "number_sequence_rule")
+		}
+	}
+
+	if v, ok := policy.GetQwertySequenceRuleOk(); ok {
+		if maxValue, ok := v.GetMaxLengthOk(); ok && longestSequenceRun(password, passwordPolicyQwertySequences) > int(*maxValue) {
+			result.Violations = append(result.Violations, "qwerty_sequence_rule")
+		}
+	}
+
+	if v, ok := policy.GetShiftedNumberRowSequenceRuleOk(); ok {
+		if maxValue, ok := v.GetMaxLengthOk(); ok && longestSequenceRun(password, passwordPolicyShiftedNumberRowSequences) > int(*maxValue) {
+			result.Violations = append(result.Violations, "shifted_number_row_sequence_rule")
+		}
+	}
+
+	if v, ok := policy.GetExcludesCommonlyUsedOk(); ok && *v {
+		// The service's list of commonly used passwords is not published
+		result.UnevaluatedRules = append(result.UnevaluatedRules, "excludes_commonly_used_passwords")
+	}
+
+	if v, ok := policy.GetExcludesProfileDataOk(); ok && *v {
+		if input.ProfileValues == nil {
+			result.UnevaluatedRules = append(result.UnevaluatedRules, "excludes_profile_data")
+		} else if passwordContainsProfileValue(input.Password, input.ProfileValues) {
+			result.Violations = append(result.Violations, "excludes_profile_data")
+		}
+	}
+
+	if v, ok := policy.GetHistoryOk(); ok {
+		if input.PreviousPasswords == nil {
+			result.UnevaluatedRules = append(result.UnevaluatedRules, "history")
+		} else {
+			previousPasswords := input.PreviousPasswords
+			if count, ok := v.GetCountOk(); ok && len(previousPasswords) > int(*count) {
+				previousPasswords = previousPasswords[:*count]
+			}
+
+			if slices.Contains(previousPasswords, input.Password) {
+				result.Violations = append(result.Violations, "history")
+			}
+		}
+	}
+
+	if v, ok := policy.GetNotSimilarToCurrentOk(); ok && *v {
+		if input.CurrentPassword == nil {
+			result.UnevaluatedRules = append(result.UnevaluatedRules, "not_similar_to_current")
+		} else if levenshteinDistance(password, []rune(*input.CurrentPassword)) < passwordPolicyNotSimilarToCurrentMinDistance {
+			result.Violations = append(result.Violations, "not_similar_to_current")
+		}
+	}
+
+	if input.CurrentPasswordAgeDays != nil {
+		if v, ok := policy.GetMinAgeDaysOk(); ok && *input.CurrentPasswordAgeDays < *v {
+			result.Violations = append(result.Violations, "password_age_min")
+		}
+
+		if v, ok := policy.GetMaxAgeDaysOk(); ok {
+			expired := *input.CurrentPasswordAgeDays > *v
+			result.CurrentPasswordExpired = &expired
+		}
+	}
+
+	if input.FailedAttempts != nil {
+		if v, ok := policy.GetLockoutOk(); ok {
+			if failureCount, ok := v.GetFailureCountOk(); ok {
+				lockedOut := *input.FailedAttempts >= *failureCount
+				result.LockedOut = &lockedOut
+			}
+		}
+	}
+
+	return result
+}
+
+// passwordContainsProfileValue returns whether the password contains any of the non-empty profile values, compared case-insensitively
+func passwordContainsProfileValue(password string, profileValues []string) bool {
+	password = strings.ToLower(password)
+
+	for _, value := range profileValues {
+		if value != "" && strings.Contains(password, strings.ToLower(value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isASCIIUpper(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+func isASCIILower(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isPasswordPolicySpecialCharacter(r rune) bool {
+	return strings.ContainsRune(passwordPolicySpecialCharacters, r)
+}
+
+func countRunesInRange(password []rune, inRange func(rune) bool) int {
+	count := 0
+	for _, r := range password {
+		if inRange(r) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func countUniqueRunes(password []rune) int {
+	unique := make(map[rune]bool)
+	for _, r := range password {
+		unique[r] = true
+	}
+
+	return len(unique)
+}
+
+// longestRepeatedRun returns the length of the longest run of the same character
+func longestRepeatedRun(password []rune) int {
+	longest, run := 0, 0
+	for i, r := range password {
+		if i > 0 && r == password[i-1] {
+			run++
+		} else {
+			run = 1
+		}
+
+		longest = max(longest, run)
+	}
+
+	return longest
+}
+
+// longestSequenceRun returns the length of the longest run of characters that are adjacent, ascending or descending, in one of the sequences.  Letters are compared case-insensitively.
+func longestSequenceRun(password []rune, sequences []string) int {
+	longest := 0
+
+	for _, sequence := range sequences {
+		positions := make(map[rune]int)
+		for i, r := range sequence {
+			positions[r] = i
+		}
+
+		run, direction := 0, 0
+		for i, r := range password {
+			position, ok := positions[unicode.ToLower(r)]
+			if !ok {
+				run, direction = 0, 0
+				continue
+			}
+
+			if run == 0 {
+				run = 1
+				longest = max(longest, run)
+				continue
+			}
+
+			previousPosition := positions[unicode.ToLower(password[i-1])]
+			step := position - previousPosition
+
+			switch {
+			case (step == 1 || step == -1) && (direction == 0 || direction == step):
+				run++
+				direction = step
+			case step == 1 || step == -1:
+				// The sequence has changed direction, so a new run starts at the previous character
+				run = 2
+				direction = step
+			default:
+				run, direction = 1, 0
+			}
+
+			longest = max(longest, run)
+		}
+	}
+
+	return longest
+}
+
+// passwordHaystackDays approximates the number of days needed to exhaust the brute force search space of a password of the same length and character classes
+func passwordHaystackDays(password []rune) float64 {
+	alphabetSize := 0
+
+	if countRunesInRange(password, isASCIILower) > 0 {
+		alphabetSize += 26
+	}
+
+	if countRunesInRange(password, isASCIIUpper) > 0 {
+		alphabetSize += 26
+	}
+
+	if countRunesInRange(password, isASCIIDigit) > 0 {
+		alphabetSize += 10
+	}
+
+	if countRunesInRange(password, func(r rune) bool { return !isASCIILower(r) && !isASCIIUpper(r) && !isASCIIDigit(r) }) > 0 {
+		alphabetSize += 33
+	}
+
+	searchSpace := 0.0
+	for length := 1; length <= len(password); length++ {
+		searchSpace += math.Pow(float64(alphabetSize), float64(length))
+	}
+
+	return searchSpace / passwordPolicyComplexityGuessesPerSecond / (60 * 60 * 24)
+}
+
+func levenshteinDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,257 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+)
+
+func TestEvaluatePasswordPolicy(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		policy   management.PasswordPolicy
+		input    passwordPolicyEvaluationInput
+		expected passwordPolicyEvaluationResult
+	}{
+		{
+			name:   "no rules",
+			policy: management.PasswordPolicy{},
+			input:  passwordPolicyEvaluationInput{Password: "a"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "length too short",
+			policy: management.PasswordPolicy{
+				Length: &management.PasswordPolicyLength{Min: management.PtrInt32(8), Max: management.PtrInt32(12)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "short"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"length.min"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "length too long",
+			policy: management.PasswordPolicy{
+				Length: &management.PasswordPolicyLength{Min: management.PtrInt32(8), Max: management.PtrInt32(12)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "waytoolongpassword"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"length.max"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "minimum characters",
+			policy: management.PasswordPolicy{
+				MinCharacters: &management.PasswordPolicyMinCharacters{
+					ABCDEFGHIJKLMNOPQRSTUVWXYZ: management.PtrInt32(1),
+					Abcdefghijklmnopqrstuvwxyz: management.PtrInt32(1),
+					Var0123456789:              management.PtrInt32(1),
+					SpecialChar:                management.PtrInt32(1),
+				},
+			},
+			input: passwordPolicyEvaluationInput{Password: "abc"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"min_characters.alphabetical_uppercase", "min_characters.numeric", "min_characters.special_characters"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "repeated and unique characters",
+			policy: management.PasswordPolicy{
+				MaxRepeatedCharacters: management.PtrInt32(2),
+				MinUniqueCharacters:   management.PtrInt32(5),
+			},
+			input: passwordPolicyEvaluationInput{Password: "aaabbc"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"max_repeated_characters", "min_unique_characters"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "minimum complexity",
+			policy: management.PasswordPolicy{
+				MinComplexity: management.PtrInt32(7),
+			},
+			input: passwordPolicyEvaluationInput{Password: "abc"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"min_complexity"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "sequences",
+			policy: management.PasswordPolicy{
+				AlphabetSequenceRule:         &management.PasswordPolicyAlphabetSequenceRule{MaxLength: management.PtrInt32(3)},
+				NumberSequenceRule:           &management.PasswordPolicyNumberSequenceRule{MaxLength: management.PtrInt32(3)},
+				QwertySequenceRule:           &management.PasswordPolicyQwertySequenceRule{MaxLength: management.PtrInt32(3)},
+				ShiftedNumberRowSequenceRule: &management.PasswordPolicyShiftedNumberRowSequenceRule{MaxLength: management.PtrInt32(3)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "DCBA-98765-asdf-!@#$"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"alphabet_sequence_rule", "number_sequence_rule", "qwerty_sequence_rule", "shifted_number_row_sequence_rule"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "sequences within limits",
+			policy: management.PasswordPolicy{
+				AlphabetSequenceRule: &management.PasswordPolicyAlphabetSequenceRule{MaxLength: management.PtrInt32(3)},
+				NumberSequenceRule:   &management.PasswordPolicyNumberSequenceRule{MaxLength: management.PtrInt32(3)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "abc-x-123"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "rules that need user context are unevaluated",
+			policy: management.PasswordPolicy{
+				ExcludesCommonlyUsed: true,
+				ExcludesProfileData:  true,
+				History:              &management.PasswordPolicyHistory{Count: management.PtrInt32(6)},
+				NotSimilarToCurrent:  true,
+			},
+			input: passwordPolicyEvaluationInput{Password: "Correct-Horse-7"},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{"excludes_commonly_used_passwords", "excludes_profile_data", "history", "not_similar_to_current"},
+			},
+		},
+		{
+			name: "profile data contained in the password",
+			policy: management.PasswordPolicy{
+				ExcludesProfileData: true,
+			},
+			input: passwordPolicyEvaluationInput{Password: "MyJDoe2024!", ProfileValues: []string{"jdoe@example.com", "jdoe"}},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"excludes_profile_data"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "profile data not contained in the password",
+			policy: management.PasswordPolicy{
+				ExcludesProfileData: true,
+			},
+			input: passwordPolicyEvaluationInput{Password: "Unrelated-7!", ProfileValues: []string{"jdoe", ""}},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "password in history",
+			policy: management.PasswordPolicy{
+				History: &management.PasswordPolicyHistory{Count: management.PtrInt32(2)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "second", PreviousPasswords: []string{"first", "second", "third"}},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"history"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "password older than the history count",
+			policy: management.PasswordPolicy{
+				History: &management.PasswordPolicyHistory{Count: management.PtrInt32(2)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "third", PreviousPasswords: []string{"first", "second", "third"}},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "similar to current password",
+			policy: management.PasswordPolicy{
+				NotSimilarToCurrent: true,
+			},
+			input: passwordPolicyEvaluationInput{Password: "Password2", CurrentPassword: management.PtrString("Password1")},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{"not_similar_to_current"},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "not similar to current password",
+			policy: management.PasswordPolicy{
+				NotSimilarToCurrent: true,
+			},
+			input: passwordPolicyEvaluationInput{Password: "Different7", CurrentPassword: management.PtrString("Password1")},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+			},
+		},
+		{
+			name: "current password too new",
+			policy: management.PasswordPolicy{
+				MinAgeDays: management.PtrInt32(1),
+				MaxAgeDays: management.PtrInt32(30),
+			},
+			input: passwordPolicyEvaluationInput{Password: "a", CurrentPasswordAgeDays: management.PtrInt32(0)},
+			expected: passwordPolicyEvaluationResult{
+				Violations:             []string{"password_age_min"},
+				UnevaluatedRules:       []string{},
+				CurrentPasswordExpired: management.PtrBool(false),
+			},
+		},
+		{
+			name: "current password expired",
+			policy: management.PasswordPolicy{
+				MinAgeDays: management.PtrInt32(1),
+				MaxAgeDays: management.PtrInt32(30),
+			},
+			input: passwordPolicyEvaluationInput{Password: "a", CurrentPasswordAgeDays: management.PtrInt32(31)},
+			expected: passwordPolicyEvaluationResult{
+				Violations:             []string{},
+				UnevaluatedRules:       []string{},
+				CurrentPasswordExpired: management.PtrBool(true),
+			},
+		},
+		{
+			name: "locked out",
+			policy: management.PasswordPolicy{
+				Lockout: &management.PasswordPolicyLockout{FailureCount: management.PtrInt32(3)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "a", FailedAttempts: management.PtrInt32(3)},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+				LockedOut:        management.PtrBool(true),
+			},
+		},
+		{
+			name: "not locked out",
+			policy: management.PasswordPolicy{
+				Lockout: &management.PasswordPolicyLockout{FailureCount: management.PtrInt32(3)},
+			},
+			input: passwordPolicyEvaluationInput{Password: "a", FailedAttempts: management.PtrInt32(2)},
+			expected: passwordPolicyEvaluationResult{
+				Violations:       []string{},
+				UnevaluatedRules: []string{},
+				LockedOut:        management.PtrBool(false),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := evaluatePasswordPolicy(&tc.policy, tc.input)
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
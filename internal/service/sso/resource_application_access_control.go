@@ -0,0 +1,536 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/utils"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// Types
+type ApplicationAccessControlResource serviceClientType
+
+type ApplicationAccessControlResourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	ApplicationId pingonetypes.ResourceIDValue `tfsdk:"application_id"`
+	RoleType      types.String                 `tfsdk:"role_type"`
+	GroupOptions  types.Object                 `tfsdk:"group_options"`
+}
+
+// Framework interfaces
+var (
+	_ resource.Resource                = &ApplicationAccessControlResource{}
+	_ resource.ResourceWithConfigure   = &ApplicationAccessControlResource{}
+	_ resource.ResourceWithImportState = &ApplicationAccessControlResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationAccessControlResource{}
+)
+
+// New Object
+func NewApplicationAccessControlResource() resource.Resource {
+	return &ApplicationAccessControlResource{}
+}
+
+// Metadata
+func (r *ApplicationAccessControlResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_access_control"
+}
+
+// Schema.
+func (r *ApplicationAccessControlResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	roleTypeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the user role required to access the application.  A user is an admin user if the user has one or more admin roles assigned, such as `Organization Admin`, `Environment Admin`, `Identity Data Admin`, or `Client Application Developer`.  When not set, no role is required to access the application.",
+	).AllowedValuesEnum(management.AllowedEnumApplicationAccessControlTypeEnumValues)
+
+	groupOptionsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A single object that specifies the groups that an actor must belong to for access to the application.  When not set, group membership is not required to access the application.",
+	)
+
+	groupOptionsTypeDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the group type required to access the application.",
+	).AllowedValuesComplex(map[string]string{
+		"ANY_GROUP":  "the actor must belong to at least one group listed in the `groups` property",
+		"ALL_GROUPS": "the actor must belong to all groups listed in the `groups` property",
+	})
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to authoritatively manage the access control of a PingOne application, separately from the application's definition.  Any access control requirement that is not defined in the configuration is removed from the application on apply, and the application's access control is removed when this resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that contains the application to manage access control for."),
+			),
+
+			"application_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the application to manage access control for."),
+			),
+
+			"role_type": schema.StringAttribute{
+				Description:         roleTypeDescription.Description,
+				MarkdownDescription: roleTypeDescription.MarkdownDescription,
+				Optional:            true,
+
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.EnumSliceToStringSlice(management.AllowedEnumApplicationAccessControlTypeEnumValues)...),
+				},
+			},
+
+			"group_options": schema.SingleNestedAttribute{
+				Description:         groupOptionsDescription.Description,
+				MarkdownDescription: groupOptionsDescription.MarkdownDescription,
+				Optional:            true,
+
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description:         groupOptionsTypeDescription.Description,
+						MarkdownDescription: groupOptionsTypeDescription.MarkdownDescription,
+						Required:            true,
+
+						Validators: []validator.String{
+							stringvalidator.OneOf(utils.EnumSliceToStringSlice(management.AllowedEnumApplicationAccessControlGroupTypeEnumValues)...),
+						},
+					},
+
+					"groups": schema.SetAttribute{
+						Description: framework.SchemaAttributeDescriptionFromMarkdown("A set that specifies the group IDs for the groups the actor must belong to for access to the application.  Values must be valid PingOne Resource IDs.").Description,
+						Required:    true,
+
+						ElementType: pingonetypes.ResourceIDType{},
+
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationAccessControlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only the create plan is checked, as on update the prior state has been refreshed and the difference is shown in the plan
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		return
+	}
+
+	var plan ApplicationAccessControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.EnvironmentId.IsUnknown() || plan.ApplicationId.IsUnknown() {
+		return
+	}
+
+	// The application may already have access control that will be replaced on create
+	application, d := fetchApplicationForAccessControl(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.ApplicationId.ValueString(), legacysdk.CustomErrorResourceNotFoundWarning)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || application == nil {
+		return
+	}
+
+	var current ApplicationAccessControlResourceModel
+	resp.Diagnostics.Append(current.toState(application)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (!current.RoleType.IsNull() && !plan.RoleType.IsUnknown() && !current.RoleType.Equal(plan.RoleType)) ||
+		(!current.GroupOptions.IsNull() && !plan.GroupOptions.IsUnknown() && !current.GroupOptions.Equal(plan.GroupOptions)) {
+		resp.Diagnostics.AddWarning(
+			"Unmanaged application access control",
+			fmt.Sprintf("Application %s already has access control that differs from the configuration, and it will be replaced on apply.", plan.ApplicationId.ValueString()),
+		)
+	}
+}
+
+func (r *ApplicationAccessControlResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *ApplicationAccessControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state ApplicationAccessControlResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	accessControl, d := plan.expand(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	response, d := updateApplicationAccessControl(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.ApplicationId.ValueString(), accessControl, legacysdk.DefaultCustomError)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ApplicationAccessControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApplicationAccessControlResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	response, d := fetchApplicationForAccessControl(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.ApplicationId.ValueString(), legacysdk.CustomErrorResourceNotFoundWarning)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if response == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationAccessControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ApplicationAccessControlResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the model for the API
+	accessControl, d := plan.expand(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	response, d := updateApplicationAccessControl(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.ApplicationId.ValueString(), accessControl, legacysdk.DefaultCustomError)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(state.toState(response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ApplicationAccessControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApplicationAccessControlResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	_, d := updateApplicationAccessControl(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.ApplicationId.ValueString(), nil, legacysdk.CustomErrorResourceNotFoundWarning)
+	resp.Diagnostics.Append(d...)
+}
+
+func (r *ApplicationAccessControlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "application_id",
+			Regexp:    verify.P1ResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathKey := idComponent.Label
+
+		if idComponent.PrimaryID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), attributes[idComponent.Label])...)
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathKey), attributes[idComponent.Label])...)
+	}
+}
+
+func fetchApplicationForAccessControl(ctx context.Context, apiClient *management.APIClient, environmentID, applicationID string, customError legacysdk.CustomError) (*management.ReadOneApplication200Response, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var response *management.ReadOneApplication200Response
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := apiClient.ApplicationsApi.ReadOneApplication(ctx, environmentID, applicationID).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, fO, fR, fErr)
+		},
+		"ReadOneApplication",
+		customError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+
+	return response, diags
+}
+
+// updateApplicationAccessControl replaces the access control of an application, leaving the rest of the application's configuration as it is in the service.  A nil access control removes the application's access control.
+func updateApplicationAccessControl(ctx context.Context, apiClient *management.APIClient, environmentID, applicationID string, accessControl *management.ApplicationAccessControl, customError legacysdk.CustomError) (*management.ReadOneApplication200Response, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	application, d := fetchApplicationForAccessControl(ctx, apiClient, environmentID, applicationID, customError)
+	diags.Append(d...)
+	if diags.HasError() || application == nil {
+		return nil, diags
+	}
+
+	updateApplication := management.UpdateApplicationRequest{}
+
+	switch v := application.GetActualInstance().(type) {
+	case *management.ApplicationExternalLink:
+		v.AccessControl = accessControl
+		updateApplication.ApplicationExternalLink = v
+	case *management.ApplicationOIDC:
+		v.AccessControl = accessControl
+		updateApplication.ApplicationOIDC = v
+	case *management.ApplicationPingOnePortal:
+		v.AccessControl = accessControl
+		updateApplication.ApplicationPingOnePortal = v
+	case *management.ApplicationPingOneSelfService:
+		v.AccessControl = accessControl
+		updateApplication.ApplicationPingOneSelfService = v
+	case *management.ApplicationSAML:
+		v.AccessControl = accessControl
+		updateApplication.ApplicationSAML = v
+	case *management.ApplicationWSFED:
+		v.AccessControl = accessControl
+		updateApplication.ApplicationWSFED = v
+	default:
+		diags.AddError(
+			"Unsupported application type",
+			fmt.Sprintf("The access control of application %s cannot be managed, as the application type is not supported.  Supported types are OIDC, SAML, WS-Fed and external link applications, and the PingOne Portal and PingOne Self-Service system applications.", applicationID),
+		)
+
+		return nil, diags
+	}
+
+	var response *management.ReadOneApplication200Response
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := apiClient.ApplicationsApi.UpdateApplication(ctx, environmentID, applicationID).UpdateApplicationRequest(updateApplication).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, fO, fR, fErr)
+		},
+		"UpdateApplication",
+		customError,
+		sdk.DefaultCreateReadRetryable,
+		&response,
+	)...)
+
+	return response, diags
+}
+
+func (p *ApplicationAccessControlResourceModel) expand(ctx context.Context) (*management.ApplicationAccessControl, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if p.RoleType.IsNull() && p.GroupOptions.IsNull() {
+		return nil, diags
+	}
+
+	data := management.NewApplicationAccessControl()
+
+	if !p.RoleType.IsNull() && !p.RoleType.IsUnknown() {
+		data.SetRole(*management.NewApplicationAccessControlRole(management.EnumApplicationAccessControlType(p.RoleType.ValueString())))
+	}
+
+	if !p.GroupOptions.IsNull() && !p.GroupOptions.IsUnknown() {
+		var plan applicationAccessControlGroupOptionsResourceModelV1
+		diags.Append(p.GroupOptions.As(ctx, &plan, basetypes.ObjectAsOptions{
+			UnhandledNullAsEmpty:    false,
+			UnhandledUnknownAsEmpty: false,
+		})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var groupsPlan []pingonetypes.ResourceIDValue
+		diags.Append(plan.Groups.ElementsAs(ctx, &groupsPlan, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		groupsStr, d := framework.TFTypePingOneResourceIDSliceToStringSlice(groupsPlan, path.Root("group_options").AtName("groups"))
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		groups := make([]management.ApplicationAccessControlGroupGroupsInner, 0)
+		for _, group := range groupsStr {
+			groups = append(groups, *management.NewApplicationAccessControlGroupGroupsInner(group))
+		}
+
+		data.SetGroup(*management.NewApplicationAccessControlGroup(
+			management.EnumApplicationAccessControlGroupType(plan.Type.ValueString()),
+			groups,
+		))
+	}
+
+	return data, diags
+}
+
+func (p *ApplicationAccessControlResourceModel) toState(apiObject *management.ReadOneApplication200Response) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || apiObject.GetActualInstance() == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	var accessControl *management.ApplicationAccessControl
+	var ok bool
+
+	switch v := apiObject.GetActualInstance().(type) {
+	case *management.ApplicationExternalLink:
+		accessControl, ok = v.GetAccessControlOk()
+	case *management.ApplicationOIDC:
+		accessControl, ok = v.GetAccessControlOk()
+	case *management.ApplicationPingOnePortal:
+		accessControl, ok = v.GetAccessControlOk()
+	case *management.ApplicationPingOneSelfService:
+		accessControl, ok = v.GetAccessControlOk()
+	case *management.ApplicationSAML:
+		accessControl, ok = v.GetAccessControlOk()
+	case *management.ApplicationWSFED:
+		accessControl, ok = v.GetAccessControlOk()
+	}
+
+	p.Id = p.ApplicationId
+	p.RoleType = types.StringNull()
+	p.GroupOptions = types.ObjectNull(applicationAccessControlGroupOptionsTFObjectTypes)
+
+	if ok && accessControl != nil {
+		if v, ok := accessControl.GetRoleOk(); ok {
+			p.RoleType = framework.EnumOkToTF(v.GetTypeOk())
+		}
+
+		var d diag.Diagnostics
+		p.GroupOptions, d = applicationAccessControlGroupOptionsToTF(accessControl.GetGroupOk())
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -0,0 +1,413 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	baselegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccApplicationAccessControl_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_application_access_control.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var applicationID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.ApplicationAccessControl_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Test removal of the application
+			{
+				Config: testAccApplicationAccessControlConfig_Full(resourceName, name, "Application description"),
+				Check:  sso.ApplicationAccessControl_GetIDs(resourceFullName, &environmentID, &applicationID),
+			},
+			{
+				PreConfig: func() {
+					sso.Application_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, applicationID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the environment
+			{
+				Config: testAccApplicationAccessControlConfig_NewEnv(environmentName, licenseID, resourceName, name),
+				Check:  sso.ApplicationAccessControl_GetIDs(resourceFullName, &environmentID, &applicationID),
+			},
+			{
+				PreConfig: func() {
+					baselegacysdk.Environment_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationAccessControl_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_application_access_control.%s", resourceName)
+
+	name := resourceName
+
+	fullCheck := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(resourceFullName, "id", fmt.Sprintf("pingone_application.%s", resourceName), "id"),
+		resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+		resource.TestCheckResourceAttrPair(resourceFullName, "application_id", fmt.Sprintf("pingone_application.%s", resourceName), "id"),
+		resource.TestCheckResourceAttr(resourceFullName, "role_type", "ADMIN_USERS_ONLY"),
+		resource.TestCheckResourceAttr(resourceFullName, "group_options.type", "ALL_GROUPS"),
+		resource.TestCheckResourceAttr(resourceFullName, "group_options.groups.#", "2"),
+		resource.TestCheckTypeSetElemAttrPair(resourceFullName, "group_options.groups.*", fmt.Sprintf("pingone_group.%s-1", resourceName), "id"),
+		resource.TestCheckTypeSetElemAttrPair(resourceFullName, "group_options.groups.*", fmt.Sprintf("pingone_group.%s-2", resourceName), "id"),
+	)
+
+	minimalCheck := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(resourceFullName, "id", fmt.Sprintf("pingone_application.%s", resourceName), "id"),
+		resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+		resource.TestCheckResourceAttrPair(resourceFullName, "application_id", fmt.Sprintf("pingone_application.%s", resourceName), "id"),
+		resource.TestCheckNoResourceAttr(resourceFullName, "role_type"),
+		resource.TestCheckNoResourceAttr(resourceFullName, "group_options"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.ApplicationAccessControl_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Full
+			{
+				Config: testAccApplicationAccessControlConfig_Full(resourceName, name, "Application description"),
+				Check:  fullCheck,
+			},
+			{
+				Config:  testAccApplicationAccessControlConfig_Full(resourceName, name, "Application description"),
+				Destroy: true,
+			},
+			// Minimal
+			{
+				Config: testAccApplicationAccessControlConfig_Minimal(resourceName, name),
+				Check:  minimalCheck,
+			},
+			{
+				Config:  testAccApplicationAccessControlConfig_Minimal(resourceName, name),
+				Destroy: true,
+			},
+			// Change
+			{
+				Config: testAccApplicationAccessControlConfig_Full(resourceName, name, "Application description"),
+				Check:  fullCheck,
+			},
+			{
+				Config: testAccApplicationAccessControlConfig_Minimal(resourceName, name),
+				Check:  minimalCheck,
+			},
+			{
+				Config: testAccApplicationAccessControlConfig_AnyGroup(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceFullName, "role_type"),
+					resource.TestCheckResourceAttr(resourceFullName, "group_options.type", "ANY_GROUP"),
+					resource.TestCheckResourceAttr(resourceFullName, "group_options.groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceFullName, "group_options.groups.*", fmt.Sprintf("pingone_group.%s-1", resourceName), "id"),
+				),
+			},
+			{
+				Config: testAccApplicationAccessControlConfig_Full(resourceName, name, "Application description"),
+				Check:  fullCheck,
+			},
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["application_id"]), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationAccessControl_ApplicationUpdate(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_application_access_control.%s", resourceName)
+	applicationResourceFullName := fmt.Sprintf("pingone_application.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.ApplicationAccessControl_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAccessControlConfig_Full(resourceName, name, "Application description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(applicationResourceFullName, "description", "Application description"),
+					resource.TestCheckResourceAttr(resourceFullName, "group_options.groups.#", "2"),
+				),
+			},
+			// Changes to the application definition leave the access control in place
+			{
+				Config: testAccApplicationAccessControlConfig_Full(resourceName, name, "Updated application description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(applicationResourceFullName, "description", "Updated application description"),
+					resource.TestCheckResourceAttr(resourceFullName, "role_type", "ADMIN_USERS_ONLY"),
+					resource.TestCheckResourceAttr(resourceFullName, "group_options.groups.#", "2"),
+				),
+			},
+			{
+				Config:   testAccApplicationAccessControlConfig_Full(resourceName, name, "Updated application description"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationAccessControl_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_application_access_control.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.ApplicationAccessControl_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationAccessControlConfig_EmptyGroups(resourceName, name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+			// Configure
+			{
+				Config: testAccApplicationAccessControlConfig_Minimal(resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccApplicationAccessControlConfig_NewEnv(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_group" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_application" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  name           = "%[4]s"
+  enabled        = true
+
+  oidc_options = {
+    type                       = "WEB_APP"
+    grant_types                = ["REFRESH_TOKEN", "AUTHORIZATION_CODE"]
+    response_types             = ["CODE"]
+    token_endpoint_auth_method = "CLIENT_SECRET_BASIC"
+    redirect_uris              = ["https://www.pingidentity.com"]
+  }
+
+  lifecycle {
+    ignore_changes = [
+      access_control_role_type,
+      access_control_group_options,
+    ]
+  }
+}
+
+resource "pingone_application_access_control" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  application_id = pingone_application.%[3]s.id
+
+  group_options = {
+    type = "ANY_GROUP"
+
+    groups = [
+      pingone_group.%[3]s.id,
+    ]
+  }
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
+
+func testAccApplicationAccessControlConfig_Base(resourceName, name, description string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_group" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-1"
+}
+
+resource "pingone_group" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-2"
+}
+
+resource "pingone_application" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+  description    = "%[4]s"
+  enabled        = true
+
+  oidc_options = {
+    type                       = "WEB_APP"
+    grant_types                = ["REFRESH_TOKEN", "AUTHORIZATION_CODE"]
+    response_types             = ["CODE"]
+    token_endpoint_auth_method = "CLIENT_SECRET_BASIC"
+    redirect_uris              = ["https://www.pingidentity.com"]
+  }
+
+  lifecycle {
+    ignore_changes = [
+      access_control_role_type,
+      access_control_group_options,
+    ]
+  }
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, description)
+}
+
+func testAccApplicationAccessControlConfig_Full(resourceName, name, applicationDescription string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_application_access_control" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  application_id = pingone_application.%[2]s.id
+
+  role_type = "ADMIN_USERS_ONLY"
+
+  group_options = {
+    type = "ALL_GROUPS"
+
+    groups = [
+      pingone_group.%[2]s-1.id,
+      pingone_group.%[2]s-2.id,
+    ]
+  }
+}`, testAccApplicationAccessControlConfig_Base(resourceName, name, applicationDescription), resourceName)
+}
+
+func testAccApplicationAccessControlConfig_AnyGroup(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_application_access_control" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  application_id = pingone_application.%[2]s.id
+
+  group_options = {
+    type = "ANY_GROUP"
+
+    groups = [
+      pingone_group.%[2]s-1.id,
+    ]
+  }
+}`, testAccApplicationAccessControlConfig_Base(resourceName, name, "Application description"), resourceName)
+}
+
+func testAccApplicationAccessControlConfig_Minimal(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_application_access_control" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  application_id = pingone_application.%[2]s.id
+}`, testAccApplicationAccessControlConfig_Base(resourceName, name, "Application description"), resourceName)
+}
+
+func testAccApplicationAccessControlConfig_EmptyGroups(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_application_access_control" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  application_id = pingone_application.%[2]s.id
+
+  group_options = {
+    type   = "ANY_GROUP"
+    groups = []
+  }
+}`, testAccApplicationAccessControlConfig_Base(resourceName, name, "Application description"), resourceName)
+}
@@ -0,0 +1,698 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/patrickcping/pingone-go-sdk-v2/pingone/model"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+// Types
+type GroupMembersResource serviceClientType
+
+type GroupMembersResourceModel struct {
+	Id             pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId  pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	GroupId        pingonetypes.ResourceIDValue `tfsdk:"group_id"`
+	UserIds        types.Set                    `tfsdk:"user_ids"`
+	NestedGroupIds types.Set                    `tfsdk:"nested_group_ids"`
+}
+
+// Framework interfaces
+var (
+	_ resource.Resource                = &GroupMembersResource{}
+	_ resource.ResourceWithConfigure   = &GroupMembersResource{}
+	_ resource.ResourceWithImportState = &GroupMembersResource{}
+	_ resource.ResourceWithModifyPlan  = &GroupMembersResource{}
+)
+
+// New Object
+func NewGroupMembersResource() resource.Resource {
+	return &GroupMembersResource{}
+}
+
+// Metadata
+func (r *GroupMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+// Schema.
+func (r *GroupMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	userIdsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A set of IDs of the users that should be direct members of the group.  Users that are direct members of the group but are not in this set are removed from the group on apply.  Indirect members, through a nested group, are not affected.  Values must be valid PingOne resource IDs.",
+	).DefaultValue("[]")
+
+	nestedGroupIdsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A set of IDs of the groups that should be nested directly in the group.  Groups that are nested in the group but are not in this set are removed from the group on apply.  Values must be valid PingOne resource IDs.",
+	).DefaultValue("[]")
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to authoritatively manage the direct members of a PingOne group, including users and nested groups.  Any direct member that is not defined in the configuration is removed from the group on apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment that contains the group to manage members for."),
+			),
+
+			"group_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the group to manage members for."),
+			),
+
+			"user_ids": schema.SetAttribute{
+				Description:         userIdsDescription.Description,
+				MarkdownDescription: userIdsDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+
+				Default: setdefault.StaticValue(types.SetValueMust(pingonetypes.ResourceIDType{}, []attr.Value{})),
+			},
+
+			"nested_group_ids": schema.SetAttribute{
+				Description:         nestedGroupIdsDescription.Description,
+				MarkdownDescription: nestedGroupIdsDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+
+				Default: setdefault.StaticValue(types.SetValueMust(pingonetypes.ResourceIDType{}, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *GroupMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.EnvironmentId.IsUnknown() || plan.GroupId.IsUnknown() || plan.UserIds.IsUnknown() || plan.NestedGroupIds.IsUnknown() {
+		return
+	}
+
+	var currentUserIDs, currentNestedGroupIDs []string
+
+	if !req.State.Raw.IsNull() {
+		// The prior state has been refreshed, so it already holds the current members of the group
+		var state GroupMembersResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(state.UserIds.ElementsAs(ctx, &currentUserIDs, false)...)
+		resp.Diagnostics.Append(state.NestedGroupIds.ElementsAs(ctx, &currentNestedGroupIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// The group may already have members that will be removed on create
+		if r.Client == nil || r.Client.ManagementAPIClient == nil {
+			return
+		}
+
+		var d diag.Diagnostics
+		currentUserIDs, currentNestedGroupIDs, d = fetchGroupDirectMembers(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.GroupId.ValueString())
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var plannedUserIDs, plannedNestedGroupIDs []string
+	resp.Diagnostics.Append(plan.UserIds.ElementsAs(ctx, &plannedUserIDs, false)...)
+	resp.Diagnostics.Append(plan.NestedGroupIds.ElementsAs(ctx, &plannedNestedGroupIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanagedUserIDs := groupMembersDifference(currentUserIDs, plannedUserIDs)
+	if len(unmanagedUserIDs) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("user_ids"),
+			"Unmanaged group members",
+			fmt.Sprintf("The following users are direct members of group %s but are not defined in the configuration, and will be removed from the group on apply: %s", plan.GroupId.ValueString(), strings.Join(unmanagedUserIDs, ", ")),
+		)
+	}
+
+	unmanagedNestedGroupIDs := groupMembersDifference(currentNestedGroupIDs, plannedNestedGroupIDs)
+	if len(unmanagedNestedGroupIDs) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("nested_group_ids"),
+			"Unmanaged nested groups",
+			fmt.Sprintf("The following groups are nested in group %s but are not defined in the configuration, and will be removed from the group on apply: %s", plan.GroupId.ValueString(), strings.Join(unmanagedNestedGroupIDs, ", ")),
+		)
+	}
+}
+
+func (r *GroupMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *GroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state GroupMembersResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *GroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *GroupMembersResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	var group *management.Group
+	resp.Diagnostics.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := r.Client.ManagementAPIClient.GroupsApi.ReadOneGroup(ctx, data.EnvironmentId.ValueString(), data.GroupId.ValueString()).Execute()
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
+		},
+		"ReadOneGroup",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		sdk.DefaultCreateReadRetryable,
+		&group,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if resource is not found
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	userIDs, nestedGroupIDs, d := fetchGroupDirectMembers(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.GroupId.ValueString())
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(userIDs, nestedGroupIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state GroupMembersResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *GroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GroupMembersResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIDs, nestedGroupIDs []string
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIDs, false)...)
+	resp.Diagnostics.Append(data.NestedGroupIds.ElementsAs(ctx, &nestedGroupIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the members managed by this resource are removed
+	resp.Diagnostics.Append(removeGroupMembers(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.GroupId.ValueString(), userIDs, nestedGroupIDs, legacysdk.CustomErrorResourceNotFoundWarning)...)
+}
+
+func (r *GroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idComponents := []framework.ImportComponent{
+		{
+			Label:  "environment_id",
+			Regexp: verify.P1ResourceIDRegexp,
+		},
+		{
+			Label:     "group_id",
+			Regexp:    verify.P1ResourceIDRegexp,
+			PrimaryID: true,
+		},
+	}
+
+	attributes, err := framework.ParseImportID(req.ID, idComponents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, idComponent := range idComponents {
+		pathKey := idComponent.Label
+
+		if idComponent.PrimaryID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), attributes[idComponent.Label])...)
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(pathKey), attributes[idComponent.Label])...)
+	}
+
+	// The member sets are populated on the subsequent read
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_ids"), types.SetValueMust(pingonetypes.ResourceIDType{}, []attr.Value{}))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("nested_group_ids"), types.SetValueMust(pingonetypes.ResourceIDType{}, []attr.Value{}))...)
+}
+
+// reconcile brings the direct members of the group in line with the plan, adding missing members and removing any that are not planned
+func (r *GroupMembersResource) reconcile(ctx context.Context, plan *GroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	environmentID := plan.EnvironmentId.ValueString()
+	groupID := plan.GroupId.ValueString()
+
+	var plannedUserIDs, plannedNestedGroupIDs []string
+	diags.Append(plan.UserIds.ElementsAs(ctx, &plannedUserIDs, false)...)
+	diags.Append(plan.NestedGroupIds.ElementsAs(ctx, &plannedNestedGroupIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The current members are read from the service rather than the prior state, so that drift is corrected on apply
+	currentUserIDs, currentNestedGroupIDs, d := fetchGroupDirectMembers(ctx, r.Client.ManagementAPIClient, environmentID, groupID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(removeGroupMembers(ctx, r.Client.ManagementAPIClient, environmentID, groupID, groupMembersDifference(currentUserIDs, plannedUserIDs), groupMembersDifference(currentNestedGroupIDs, plannedNestedGroupIDs), legacysdk.DefaultCustomError)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, userID := range groupMembersDifference(plannedUserIDs, currentUserIDs) {
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.ManagementAPIClient.GroupMembershipApi.AddUserToGroup(ctx, environmentID, userID).GroupMembership(*management.NewGroupMembership(groupID)).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, environmentID, fO, fR, fErr)
+			},
+			"AddUserToGroup",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			nil,
+		)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, nestedGroupID := range groupMembersDifference(plannedNestedGroupIDs, currentNestedGroupIDs) {
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := r.Client.ManagementAPIClient.GroupsApi.CreateGroupNesting(ctx, environmentID, groupID).GroupNesting(*management.NewGroupNesting(nestedGroupID)).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, environmentID, fO, fR, fErr)
+			},
+			"CreateGroupNesting",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			nil,
+		)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	plan.Id = plan.GroupId
+
+	return diags
+}
+
+func removeGroupMembers(ctx context.Context, apiClient *management.APIClient, environmentID, groupID string, userIDs, nestedGroupIDs []string, customError legacysdk.CustomError) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, userID := range userIDs {
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fR, fErr := apiClient.GroupMembershipApi.RemoveUserFromGroup(ctx, environmentID, userID, groupID).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, fR, fErr)
+			},
+			"RemoveUserFromGroup",
+			customError,
+			nil,
+			nil,
+		)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, nestedGroupID := range nestedGroupIDs {
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fR, fErr := apiClient.GroupsApi.DeleteGroupNesting(ctx, environmentID, groupID, nestedGroupID).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, fR, fErr)
+			},
+			"DeleteGroupNesting",
+			customError,
+			nil,
+			nil,
+		)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// fetchGroupDirectMembers returns the IDs of the users that are direct members of the group, and the IDs of the groups nested in the group
+func fetchGroupDirectMembers(ctx context.Context, apiClient *management.APIClient, environmentID, groupID string) ([]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Direct members are listed with a single filtered query on the membership type
+	directFilterUnsupported := false
+	userIDs, d := fetchGroupMemberUserIDs(ctx, apiClient, environmentID, fmt.Sprintf(`memberOfGroups[id eq "%s" and type eq "DIRECT"]`, groupID), func(r *http.Response, p1Error *model.P1Error) diag.Diagnostics {
+		if r != nil && r.StatusCode == http.StatusBadRequest && isInvalidFilterError(p1Error) {
+			directFilterUnsupported = true
+			return diag.Diagnostics{}
+		}
+
+		return nil
+	})
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	// Where the membership type filter is not supported by the environment, all members are listed and each membership is read to
+	// exclude indirect members.  This costs one additional API call per member of the group.
+	if directFilterUnsupported {
+		userIDs, d = fetchGroupDirectMemberUserIDsByMembership(ctx, apiClient, environmentID, groupID)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+	}
+
+	var nestedGroupIDs []string
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := apiClient.GroupsApi.ReadGroupNesting(ctx, environmentID, groupID).Execute()
+
+			foundIDs := make([]string, 0)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if groupMemberships, ok := pageCursor.EntityArray.Embedded.GetGroupMembershipsOk(); ok {
+					for _, groupMembership := range groupMemberships {
+						if v, ok := groupMembership.GetTypeOk(); ok && *v == management.ENUMGROUPMEMBERSHIPTYPE_INDIRECT {
+							continue
+						}
+
+						foundIDs = append(foundIDs, groupMembership.GetId())
+					}
+				}
+			}
+
+			return foundIDs, initialHttpResponse, nil
+		},
+		"ReadGroupNesting",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&nestedGroupIDs,
+	)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return userIDs, nestedGroupIDs, diags
+}
+
+// isInvalidFilterError returns whether the PingOne error reports that the filter of a list request is invalid or not supported
+func isInvalidFilterError(p1Error *model.P1Error) bool {
+	if p1Error == nil {
+		return false
+	}
+
+	if p1Error.GetCode() == "INVALID_FILTER" {
+		return true
+	}
+
+	for _, detail := range p1Error.GetDetails() {
+		if detail.GetCode() == "INVALID_FILTER" || detail.GetTarget() == "filter" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fetchGroupMemberUserIDs returns the IDs of the users that match a group membership SCIM filter
+func fetchGroupMemberUserIDs(ctx context.Context, apiClient *management.APIClient, environmentID, scimFilter string, customError legacysdk.CustomError) ([]string, diag.Diagnostics) {
+	var userIDs []string
+	diags := legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := apiClient.UsersApi.ReadAllUsers(ctx, environmentID).Filter(scimFilter).Execute()
+
+			foundIDs := make([]string, 0)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if users, ok := pageCursor.EntityArray.Embedded.GetUsersOk(); ok {
+					for _, user := range users {
+						foundIDs = append(foundIDs, user.GetId())
+					}
+				}
+			}
+
+			return foundIDs, initialHttpResponse, nil
+		},
+		"ReadAllUsers",
+		customError,
+		sdk.DefaultCreateReadRetryable,
+		&userIDs,
+	)
+
+	return userIDs, diags
+}
+
+// fetchGroupDirectMemberUserIDsByMembership returns the IDs of the users that are direct members of the group by reading the membership of
+// each direct or indirect member
+func fetchGroupDirectMemberUserIDsByMembership(ctx context.Context, apiClient *management.APIClient, environmentID, groupID string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The user filter matches both direct and indirect members
+	candidateUserIDs, d := fetchGroupMemberUserIDs(ctx, apiClient, environmentID, fmt.Sprintf(`memberOfGroups[id eq "%s"]`, groupID), legacysdk.DefaultCustomError)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	userIDs := make([]string, 0, len(candidateUserIDs))
+	for _, userID := range candidateUserIDs {
+		var membership *management.GroupMembership
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := apiClient.GroupMembershipApi.ReadOneGroupMembershipForUser(ctx, environmentID, userID, groupID).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, fO, fR, fErr)
+			},
+			"ReadOneGroupMembershipForUser",
+			legacysdk.CustomErrorResourceNotFoundWarning,
+			sdk.DefaultCreateReadRetryable,
+			&membership,
+		)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if membership == nil {
+			continue
+		}
+
+		if v, ok := membership.GetTypeOk(); ok && *v == management.ENUMGROUPMEMBERSHIPTYPE_INDIRECT {
+			continue
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, diags
+}
+
+// groupMembersDifference returns the IDs in a that are not in b, in a stable order
+func groupMembersDifference(a, b []string) []string {
+	v := make([]string, 0)
+
+	for _, id := range a {
+		if !slices.Contains(b, id) && !slices.Contains(v, id) {
+			v = append(v, id)
+		}
+	}
+
+	slices.Sort(v)
+
+	return v
+}
+
+func (p *GroupMembersResourceModel) toState(userIDs, nestedGroupIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if userIDs == nil || nestedGroupIDs == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	p.Id = p.GroupId
+	p.UserIds = framework.PingOneResourceIDSetToTF(userIDs)
+	p.NestedGroupIds = framework.PingOneResourceIDSetToTF(nestedGroupIDs)
+
+	return diags
+}
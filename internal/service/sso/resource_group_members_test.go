@@ -0,0 +1,377 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	baselegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccGroupMembers_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_group_members.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var groupID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.GroupMembers_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Test removal of the group
+			{
+				Config: testAccGroupMembersConfig_Full(resourceName, name),
+				Check:  sso.GroupMembers_GetIDs(resourceFullName, &environmentID, &groupID),
+			},
+			{
+				PreConfig: func() {
+					sso.Group_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, groupID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the environment
+			{
+				Config: testAccGroupMembersConfig_NewEnv(environmentName, licenseID, resourceName, name),
+				Check:  sso.GroupMembers_GetIDs(resourceFullName, &environmentID, &groupID),
+			},
+			{
+				PreConfig: func() {
+					baselegacysdk.Environment_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroupMembers_UnmanagedMemberDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_group_members.%s", resourceName)
+	unmanagedUserFullName := fmt.Sprintf("pingone_user.%s-3", resourceName)
+
+	name := resourceName
+
+	var groupID, environmentID, unmanagedUserID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.GroupMembers_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembersConfig_Full(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					sso.GroupMembers_GetIDs(resourceFullName, &environmentID, &groupID),
+					sso.User_GetIDs(unmanagedUserFullName, nil, &unmanagedUserID),
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.#", "2"),
+				),
+			},
+			// A member added outside of Terraform is detected as drift
+			{
+				PreConfig: func() {
+					sso.GroupMembers_UnmanagedMember_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, groupID, unmanagedUserID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(resourceFullName, "user_ids.*", unmanagedUserFullName, "id"),
+				),
+			},
+			// The unmanaged member is removed on apply
+			{
+				Config: testAccGroupMembersConfig_Full(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceFullName, "user_ids.*", fmt.Sprintf("pingone_user.%s-1", resourceName), "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceFullName, "user_ids.*", fmt.Sprintf("pingone_user.%s-2", resourceName), "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupMembers_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_group_members.%s", resourceName)
+
+	name := resourceName
+
+	fullCheck := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(resourceFullName, "id", fmt.Sprintf("pingone_group.%s", resourceName), "id"),
+		resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+		resource.TestCheckResourceAttrPair(resourceFullName, "group_id", fmt.Sprintf("pingone_group.%s", resourceName), "id"),
+		resource.TestCheckResourceAttr(resourceFullName, "user_ids.#", "2"),
+		resource.TestCheckTypeSetElemAttrPair(resourceFullName, "user_ids.*", fmt.Sprintf("pingone_user.%s-1", resourceName), "id"),
+		resource.TestCheckTypeSetElemAttrPair(resourceFullName, "user_ids.*", fmt.Sprintf("pingone_user.%s-2", resourceName), "id"),
+		resource.TestCheckResourceAttr(resourceFullName, "nested_group_ids.#", "1"),
+		resource.TestCheckTypeSetElemAttrPair(resourceFullName, "nested_group_ids.*", fmt.Sprintf("pingone_group.%s-nesting", resourceName), "id"),
+	)
+
+	minimalCheck := resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrPair(resourceFullName, "id", fmt.Sprintf("pingone_group.%s", resourceName), "id"),
+		resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+		resource.TestCheckResourceAttrPair(resourceFullName, "group_id", fmt.Sprintf("pingone_group.%s", resourceName), "id"),
+		resource.TestCheckResourceAttr(resourceFullName, "user_ids.#", "0"),
+		resource.TestCheckResourceAttr(resourceFullName, "nested_group_ids.#", "0"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.GroupMembers_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Full
+			{
+				Config: testAccGroupMembersConfig_Full(resourceName, name),
+				Check:  fullCheck,
+			},
+			{
+				Config:  testAccGroupMembersConfig_Full(resourceName, name),
+				Destroy: true,
+			},
+			// Minimal
+			{
+				Config: testAccGroupMembersConfig_Minimal(resourceName, name),
+				Check:  minimalCheck,
+			},
+			{
+				Config:  testAccGroupMembersConfig_Minimal(resourceName, name),
+				Destroy: true,
+			},
+			// Change
+			{
+				Config: testAccGroupMembersConfig_Full(resourceName, name),
+				Check:  fullCheck,
+			},
+			{
+				Config: testAccGroupMembersConfig_Minimal(resourceName, name),
+				Check:  minimalCheck,
+			},
+			{
+				Config: testAccGroupMembersConfig_Full(resourceName, name),
+				Check:  fullCheck,
+			},
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["group_id"]), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroupMembers_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_group_members.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.GroupMembers_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccGroupMembersConfig_Minimal(resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccGroupMembersConfig_NewEnv(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_group" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_population" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_user" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  username      = "%[4]s"
+  email         = "%[4]s@pingidentity.com"
+  population_id = pingone_population.%[3]s.id
+}
+
+resource "pingone_group_members" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  group_id       = pingone_group.%[3]s.id
+
+  user_ids = [
+    pingone_user.%[3]s.id,
+  ]
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
+
+func testAccGroupMembersConfig_Base(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_group" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_group" "%[2]s-nesting" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s-nesting"
+}
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s-1" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-1"
+  email         = "%[3]s-1@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}
+
+resource "pingone_user" "%[2]s-2" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-2"
+  email         = "%[3]s-2@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}
+
+resource "pingone_user" "%[2]s-3" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s-3"
+  email         = "%[3]s-3@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccGroupMembersConfig_Full(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_group_members" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  group_id       = pingone_group.%[2]s.id
+
+  user_ids = [
+    pingone_user.%[2]s-1.id,
+    pingone_user.%[2]s-2.id,
+  ]
+
+  nested_group_ids = [
+    pingone_group.%[2]s-nesting.id,
+  ]
+}`, testAccGroupMembersConfig_Base(resourceName, name), resourceName)
+}
+
+func testAccGroupMembersConfig_Minimal(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_group_members" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  group_id       = pingone_group.%[2]s.id
+}`, testAccGroupMembersConfig_Base(resourceName, name), resourceName)
+}
@@ -4,10 +4,12 @@ package sso
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -50,6 +52,7 @@ type UserResourceModel struct {
 	PopulationId      pingonetypes.ResourceIDValue `tfsdk:"population_id"`
 	Account           types.Object                 `tfsdk:"account"`
 	Address           types.Object                 `tfsdk:"address"`
+	CustomAttributes  jsontypes.Normalized         `tfsdk:"custom_attributes"`
 	ExternalId        types.String                 `tfsdk:"external_id"`
 	IdentityProvider  types.Object                 `tfsdk:"identity_provider"`
 	Lifecycle         types.Object                 `tfsdk:"user_lifecycle"`
@@ -343,6 +346,10 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		"A string that specifies the user's type, which is optional. This can be explicitly set to null when updating a user to unset it. This attribute is organization-specific and has no special meaning within the PingOne platform. It is a free-text field that could have values of (for example) `Contractor`, `Employee`, `Intern`, `Temp`, `External`, and `Unknown`. The string can contain any letters, numbers, combining characters, math and currency symbols, dingbats and drawing characters, and invisible whitespace. It can have a length of no more than 256 characters.",
 	)
 
+	customAttributesDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A JSON string that specifies the values of the user's custom attributes, keyed by attribute name.  Custom attributes are defined in the environment's `User` schema, for example with the `pingone_schema_attribute` resource.  Values are validated against the schema at plan time where possible, including the attribute type, `enumerated_values`, `regex_validation` and `multivalued` settings.  When set, the provider manages all custom attribute values of the user, and custom attributes not present in the JSON are removed.  When not set, custom attribute values are not managed by Terraform.",
+	)
+
 	verifyStatusDescription := framework.SchemaAttributeDescriptionFromMarkdown(
 		"A string that indicates whether ID verification can be done for the user.",
 	).AllowedValuesEnum(management.AllowedEnumUserVerifyStatusEnumValues).AppendMarkdownString(
@@ -522,6 +529,14 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 
+			"custom_attributes": schema.StringAttribute{
+				Description:         customAttributesDescription.Description,
+				MarkdownDescription: customAttributesDescription.MarkdownDescription,
+				Optional:            true,
+
+				CustomType: jsontypes.NormalizedType{},
+			},
+
 			"external_id": schema.StringAttribute{
 				Description: framework.SchemaAttributeDescriptionFromMarkdown(
 					"A string that specifies an identifier for the user resource as defined by the provisioning client. This may be explicitly set to null when updating a user to unset it. The external id attribute simplifies the correlation of the user in PingOne with the user's account in another system of record. The platform does not use this attribute directly in any way, but it is used by Ping Identity's Data Sync product. It can have a length of no more than 1024 characters.",
@@ -955,6 +970,32 @@ func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("account").AtName("locked_at"), timetypes.NewRFC3339Null())...)
 		}
 	}
+
+	// Validate the custom attribute values against the environment's user schema
+	if !plan.CustomAttributes.IsNull() && !plan.CustomAttributes.IsUnknown() {
+		var customAttributes map[string]any
+		if err := json.Unmarshal([]byte(plan.CustomAttributes.ValueString()), &customAttributes); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_attributes"),
+				"Invalid custom attributes",
+				fmt.Sprintf("The custom attributes must be a JSON object, keyed by attribute name: %s", err),
+			)
+			return
+		}
+
+		// The schema can only be read when the environment already exists and the provider is configured
+		if plan.EnvironmentId.IsUnknown() || r.Client == nil || r.Client.ManagementAPIClient == nil {
+			return
+		}
+
+		schemaAttributes, d := fetchUserCustomSchemaAttributes(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString())
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateUserCustomAttributes(path.Root("custom_attributes"), customAttributes, schemaAttributes, true)...)
+	}
 }
 
 func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -1006,6 +1047,16 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Validate the custom attribute values before the user is created, as the schema attributes may have been created in the same plan
+	var customAttributeSchema map[string]management.SchemaAttribute
+	if !plan.CustomAttributes.IsNull() {
+		customAttributeSchema, d = r.validateCustomAttributes(ctx, plan.EnvironmentId.ValueString(), plan.CustomAttributes)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Run the API call
 	// Create the user
 	var createUserResponse *management.User
@@ -1086,13 +1137,29 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		}
 	}
 
+	// Update the custom attribute values
+	if !plan.CustomAttributes.IsNull() {
+		customAttributes, d := userCustomAttributesPatchBody(plan.CustomAttributes, jsontypes.NewNormalizedNull())
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(updateUserCustomAttributes(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), createUserResponse.GetId(), customAttributes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Read the user object again, as other attributes may have changed following the update API calls
 	var finalUserResponse *management.User
+	var finalUserHttpResponse *http.Response
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
 		func() (any, *http.Response, error) {
 			fO, fR, fErr := r.Client.ManagementAPIClient.UsersApi.ReadUser(ctx, plan.EnvironmentId.ValueString(), createUserResponse.GetId()).Execute()
+			finalUserHttpResponse = fR
 			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
 		},
 		"ReadUser",
@@ -1109,6 +1176,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(state.toState(ctx, finalUserResponse)...)
+
+	if !plan.CustomAttributes.IsNull() {
+		state.CustomAttributes, d = userCustomAttributesFromHTTPResponse(finalUserHttpResponse, customAttributeSchema)
+		resp.Diagnostics.Append(d...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -1130,11 +1203,13 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Run the API call
 	var response *management.User
+	var httpResponse *http.Response
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
 		func() (any, *http.Response, error) {
 			fO, fR, fErr := r.Client.ManagementAPIClient.UsersApi.ReadUser(ctx, data.EnvironmentId.ValueString(), data.Id.ValueString()).Execute()
+			httpResponse = fR
 			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), fO, fR, fErr)
 		},
 		"ReadUser",
@@ -1177,6 +1252,14 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(data.toState(ctx, response)...)
+
+	// Custom attribute values are only read when managed
+	if !data.CustomAttributes.IsNull() {
+		var d diag.Diagnostics
+		data.CustomAttributes, d = userCustomAttributesToTF(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), httpResponse)
+		resp.Diagnostics.Append(d...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -1203,6 +1286,16 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Validate the custom attribute values before the user is updated, as the schema attributes may have been created in the same plan
+	var customAttributeSchema map[string]management.SchemaAttribute
+	if !plan.CustomAttributes.IsNull() {
+		customAttributeSchema, d = r.validateCustomAttributes(ctx, plan.EnvironmentId.ValueString(), plan.CustomAttributes)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Run the API calls
 	if state.PopulationId.ValueString() != plan.PopulationId.ValueString() {
 
@@ -1301,12 +1394,34 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	// Update the custom attribute values
+	if !plan.CustomAttributes.IsNull() {
+		var priorCustomAttributes jsontypes.Normalized
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("custom_attributes"), &priorCustomAttributes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		customAttributes, d := userCustomAttributesPatchBody(plan.CustomAttributes, priorCustomAttributes)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(updateUserCustomAttributes(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), customAttributes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var finalUserResponse *management.User
+	var finalUserHttpResponse *http.Response
 	resp.Diagnostics.Append(legacysdk.ParseResponse(
 		ctx,
 
 		func() (any, *http.Response, error) {
 			fO, fR, fErr := r.Client.ManagementAPIClient.UsersApi.ReadUser(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString()).Execute()
+			finalUserHttpResponse = fR
 			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), fO, fR, fErr)
 		},
 		"ReadUser",
@@ -1323,6 +1438,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(state.toState(ctx, finalUserResponse)...)
+
+	if !plan.CustomAttributes.IsNull() {
+		state.CustomAttributes, d = userCustomAttributesFromHTTPResponse(finalUserHttpResponse, customAttributeSchema)
+		resp.Diagnostics.Append(d...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -1395,6 +1516,26 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	}
 }
 
+func (r *UserResource) validateCustomAttributes(ctx context.Context, environmentID string, customAttributes jsontypes.Normalized) (map[string]management.SchemaAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var customAttributesMap map[string]any
+	diags.Append(customAttributes.Unmarshal(&customAttributesMap)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schemaAttributes, d := fetchUserCustomSchemaAttributes(ctx, r.Client.ManagementAPIClient, environmentID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	diags.Append(validateUserCustomAttributes(path.Root("custom_attributes"), customAttributesMap, schemaAttributes, false)...)
+
+	return schemaAttributes, diags
+}
+
 var (
 	userAccountEnabledRetryable = func(ctx context.Context, r *http.Response, p1error *model.P1Error) bool {
 
@@ -0,0 +1,628 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/customtypes/pingonetypes"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+// Types
+type UserBulkImportResource serviceClientType
+
+type UserBulkImportResourceModel struct {
+	Id            pingonetypes.ResourceIDValue `tfsdk:"id"`
+	EnvironmentId pingonetypes.ResourceIDValue `tfsdk:"environment_id"`
+	PopulationId  pingonetypes.ResourceIDValue `tfsdk:"population_id"`
+	CsvContent    types.String                 `tfsdk:"csv_content"`
+	JsonlContent  types.String                 `tfsdk:"jsonl_content"`
+	Users         types.List                   `tfsdk:"users"`
+	DeleteUsers   types.Bool                   `tfsdk:"delete_users"`
+	ContentHash   types.String                 `tfsdk:"content_hash"`
+	UserIds       types.Map                    `tfsdk:"user_ids"`
+}
+
+type UserBulkImportUserResourceModel struct {
+	Username     types.String `tfsdk:"username"`
+	Email        types.String `tfsdk:"email"`
+	GivenName    types.String `tfsdk:"given_name"`
+	FamilyName   types.String `tfsdk:"family_name"`
+	ExternalId   types.String `tfsdk:"external_id"`
+	PasswordHash types.String `tfsdk:"password_hash"`
+}
+
+// Framework interfaces
+var (
+	_ resource.Resource                   = &UserBulkImportResource{}
+	_ resource.ResourceWithConfigure      = &UserBulkImportResource{}
+	_ resource.ResourceWithValidateConfig = &UserBulkImportResource{}
+	_ resource.ResourceWithModifyPlan     = &UserBulkImportResource{}
+)
+
+// New Object
+func NewUserBulkImportResource() resource.Resource {
+	return &UserBulkImportResource{}
+}
+
+// Metadata
+func (r *UserBulkImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_bulk_import"
+}
+
+// Schema.
+func (r *UserBulkImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	const attrMinLength = 1
+
+	sourcePaths := []string{"csv_content", "jsonl_content", "users"}
+
+	populationIdDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"The ID of the population to import users into.  Existing users matched by username are moved into this population.  Must be a valid PingOne resource ID.",
+	)
+
+	csvContentDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the users to import in CSV format, typically read with the `file()` function.  The first row must be a header row that contains the `username` and `email` columns, and may also contain the `given_name`, `family_name`, `external_id` and `password_hash` columns.",
+	).ExactlyOneOf(sourcePaths)
+
+	jsonlContentDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the users to import in JSON Lines format, typically read with the `file()` function.  Each line is a JSON object that must contain the `username` and `email` keys, and may also contain the `given_name`, `family_name`, `external_id` and `password_hash` keys.",
+	).ExactlyOneOf(sourcePaths)
+
+	usersDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A list of users to import.",
+	).ExactlyOneOf(sourcePaths)
+
+	passwordHashDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A string that specifies the user's password in pre-encoded format, such as a salted SHA-512 or bcrypt hash exported from the legacy directory.  The password is only set when the user is created by the import.  Password hashes are not read back from the platform and are not included in the `content_hash`.",
+	)
+
+	deleteUsersDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A boolean that specifies whether imported users are deleted from the environment when they are removed from the source, or when the resource is destroyed.  When `false`, users are left in place and only removed from Terraform state.",
+	).DefaultValue(false)
+
+	contentHashDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A SHA-256 hash of the imported user data.  On refresh, the hash is recalculated from the users in the platform, so that users that have been changed or removed outside of Terraform are reported as drift and re-imported on the next apply.",
+	)
+
+	userIdsDescription := framework.SchemaAttributeDescriptionFromMarkdown(
+		"A map of the imported users' IDs, keyed by username.",
+	)
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Resource to import and manage a large number of users in a PingOne population from CSV, JSON Lines or inline source data.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": framework.Attr_ID(),
+
+			"environment_id": framework.Attr_LinkID(
+				framework.SchemaAttributeDescriptionFromMarkdown("The ID of the environment to import users into."),
+			),
+
+			"population_id": schema.StringAttribute{
+				Description:         populationIdDescription.Description,
+				MarkdownDescription: populationIdDescription.MarkdownDescription,
+				Required:            true,
+
+				CustomType: pingonetypes.ResourceIDType{},
+			},
+
+			"csv_content": schema.StringAttribute{
+				Description:         csvContentDescription.Description,
+				MarkdownDescription: csvContentDescription.MarkdownDescription,
+				Optional:            true,
+				Sensitive:           true,
+
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("jsonl_content"),
+						path.MatchRelative().AtParent().AtName("users"),
+					),
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"jsonl_content": schema.StringAttribute{
+				Description:         jsonlContentDescription.Description,
+				MarkdownDescription: jsonlContentDescription.MarkdownDescription,
+				Optional:            true,
+				Sensitive:           true,
+
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("csv_content"),
+						path.MatchRelative().AtParent().AtName("users"),
+					),
+					stringvalidator.LengthAtLeast(attrMinLength),
+				},
+			},
+
+			"users": schema.ListNestedAttribute{
+				Description:         usersDescription.Description,
+				MarkdownDescription: usersDescription.MarkdownDescription,
+				Optional:            true,
+
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the user name, which is used to match existing users in the environment.").Description,
+							Required:    true,
+
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(attrMinLength),
+							},
+						},
+
+						"email": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the user's email address.").Description,
+							Required:    true,
+
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(attrMinLength),
+							},
+						},
+
+						"given_name": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the user's given name.").Description,
+							Optional:    true,
+						},
+
+						"family_name": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies the user's family name.").Description,
+							Optional:    true,
+						},
+
+						"external_id": schema.StringAttribute{
+							Description: framework.SchemaAttributeDescriptionFromMarkdown("A string that specifies an identifier for the user in an external system of record.").Description,
+							Optional:    true,
+						},
+
+						"password_hash": schema.StringAttribute{
+							Description:         passwordHashDescription.Description,
+							MarkdownDescription: passwordHashDescription.MarkdownDescription,
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("csv_content"),
+						path.MatchRelative().AtParent().AtName("jsonl_content"),
+					),
+					listvalidator.SizeAtLeast(attrMinLength),
+				},
+			},
+
+			"delete_users": schema.BoolAttribute{
+				Description:         deleteUsersDescription.Description,
+				MarkdownDescription: deleteUsersDescription.MarkdownDescription,
+				Optional:            true,
+				Computed:            true,
+
+				Default: booldefault.StaticBool(false),
+			},
+
+			"content_hash": schema.StringAttribute{
+				Description:         contentHashDescription.Description,
+				MarkdownDescription: contentHashDescription.MarkdownDescription,
+				Computed:            true,
+			},
+
+			"user_ids": schema.MapAttribute{
+				Description:         userIdsDescription.Description,
+				MarkdownDescription: userIdsDescription.MarkdownDescription,
+				Computed:            true,
+
+				ElementType: pingonetypes.ResourceIDType{},
+			},
+		},
+	}
+}
+
+func (r *UserBulkImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserBulkImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse errors are reported against the source attribute
+	_, _, d := data.records(ctx)
+	resp.Diagnostics.Append(d...)
+}
+
+// ModifyPlan
+func (r *UserBulkImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *UserBulkImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, known, d := plan.records(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_ids"), types.MapUnknown(pingonetypes.ResourceIDType{}))...)
+		return
+	}
+
+	contentHash := userBulkImportContentHash(records)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringValue(contentHash))...)
+
+	// The user IDs only change when users are imported, or the users in the platform have drifted from the source
+	if state != nil && state.ContentHash.ValueString() == contentHash && state.PopulationId.Equal(plan.PopulationId) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_ids"), state.UserIds)...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_ids"), types.MapUnknown(pingonetypes.ResourceIDType{}))...)
+	}
+}
+
+func (r *UserBulkImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceConfig, ok := req.ProviderData.(legacysdk.ResourceType)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected the provider client, got: %T. Please report this issue to the provider maintainers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = resourceConfig.Client.API
+	if r.Client == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialised",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.",
+		)
+		return
+	}
+}
+
+func (r *UserBulkImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state UserBulkImportResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, _, d := plan.records(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	userIDs, d := importUserBulkImportRecords(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.PopulationId.ValueString(), records, map[string]string{})
+	resp.Diagnostics.Append(d...)
+
+	// Create the state to save
+	state = plan
+	state.Id = framework.PingOneResourceIDToTF(uuid.New().String())
+
+	// Save updated data into Terraform state.  Rows that could not be imported are left out of user_ids, so the next plan detects the change in content and the rows are imported again on the next apply.
+	resp.Diagnostics.Append(state.toState(ctx, records, userIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *UserBulkImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UserBulkImportResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, _, d := data.records(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	knownUserIDs, d := data.userIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API call
+	users, d := readUserBulkImportPopulationUsers(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), data.PopulationId.ValueString())
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove from state if the environment is not found
+	if users == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	observedRecords := make([]userBulkImportRecord, 0, len(records))
+	observedUserIDs := make(map[string]string, len(knownUserIDs))
+
+	for _, record := range records {
+		userID, ok := knownUserIDs[record.Username]
+		if !ok {
+			continue
+		}
+
+		user, ok := users[userID]
+		if !ok {
+			continue
+		}
+
+		observedRecords = append(observedRecords, observedUserBulkImportRecord(record, user))
+		observedUserIDs[record.Username] = userID
+	}
+
+	data.ContentHash = framework.StringToTF(userBulkImportContentHash(observedRecords))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(data.toState(ctx, nil, observedUserIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserBulkImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserBulkImportResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, _, d := plan.records(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	knownUserIDs, d := state.userIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the API calls
+	userIDs, d := importUserBulkImportRecords(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), plan.PopulationId.ValueString(), records, knownUserIDs)
+	resp.Diagnostics.Append(d...)
+
+	// Users removed from the source
+	if plan.DeleteUsers.ValueBool() {
+		removedUserIDs := make([]string, 0)
+		for username, userID := range knownUserIDs {
+			if _, ok := userIDs[username]; !ok {
+				removedUserIDs = append(removedUserIDs, userID)
+			}
+		}
+
+		resp.Diagnostics.Append(deleteUserBulkImportUsers(ctx, r.Client.ManagementAPIClient, plan.EnvironmentId.ValueString(), removedUserIDs)...)
+	}
+
+	// Create the state to save
+	state = plan
+
+	// Save updated data into Terraform state.  Rows that could not be imported are left out of user_ids, so the next plan detects the change in content and the rows are imported again on the next apply.
+	resp.Diagnostics.Append(state.toState(ctx, records, userIDs)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *UserBulkImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UserBulkImportResourceModel
+
+	if r.Client == nil || r.Client.ManagementAPIClient == nil {
+		resp.Diagnostics.AddError(
+			"Client not initialized",
+			"Expected the PingOne client, got nil.  Please report this issue to the provider maintainers.")
+		return
+	}
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeleteUsers.ValueBool() {
+		return
+	}
+
+	knownUserIDs, d := data.userIDs(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs := make([]string, 0, len(knownUserIDs))
+	for _, userID := range knownUserIDs {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	// Run the API calls
+	resp.Diagnostics.Append(deleteUserBulkImportUsers(ctx, r.Client.ManagementAPIClient, data.EnvironmentId.ValueString(), userIDs)...)
+}
+
+// records returns the user records from whichever source is configured.  The returned boolean is false when the source
+// is not yet known.
+func (p *UserBulkImportResourceModel) records(ctx context.Context) ([]userBulkImportRecord, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case p.CsvContent.IsUnknown() || p.JsonlContent.IsUnknown() || p.Users.IsUnknown():
+		return nil, false, diags
+
+	case !p.CsvContent.IsNull():
+		records, err := parseUserBulkImportCSV(p.CsvContent.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("csv_content"),
+				"Invalid CSV content",
+				fmt.Sprintf("The users cannot be read from the CSV content: %s", err),
+			)
+		}
+
+		return records, true, diags
+
+	case !p.JsonlContent.IsNull():
+		records, err := parseUserBulkImportJSONL(p.JsonlContent.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("jsonl_content"),
+				"Invalid JSONL content",
+				fmt.Sprintf("The users cannot be read from the JSON Lines content: %s", err),
+			)
+		}
+
+		return records, true, diags
+
+	case !p.Users.IsNull():
+		for _, v := range p.Users.Elements() {
+			if v.IsUnknown() {
+				return nil, false, diags
+			}
+		}
+
+		var plan []UserBulkImportUserResourceModel
+		diags.Append(p.Users.ElementsAs(ctx, &plan, false)...)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		records := make([]userBulkImportRecord, 0, len(plan))
+		for i, v := range plan {
+			if v.Username.IsUnknown() || v.Email.IsUnknown() || v.GivenName.IsUnknown() || v.FamilyName.IsUnknown() || v.ExternalId.IsUnknown() || v.PasswordHash.IsUnknown() {
+				return nil, false, diags
+			}
+
+			records = append(records, userBulkImportRecord{
+				Row:          i + 1,
+				Username:     v.Username.ValueString(),
+				Email:        v.Email.ValueString(),
+				GivenName:    v.GivenName.ValueString(),
+				FamilyName:   v.FamilyName.ValueString(),
+				ExternalId:   v.ExternalId.ValueString(),
+				PasswordHash: v.PasswordHash.ValueString(),
+			})
+		}
+
+		if err := validateUserBulkImportRecords(records); err != nil {
+			diags.AddAttributeError(
+				path.Root("users"),
+				"Invalid users",
+				fmt.Sprintf("The users cannot be imported: %s", err),
+			)
+		}
+
+		return records, true, diags
+	}
+
+	return nil, false, diags
+}
+
+func (p *UserBulkImportResourceModel) userIDs(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	userIDs := make(map[string]string)
+
+	if p.UserIds.IsNull() || p.UserIds.IsUnknown() {
+		return userIDs, diags
+	}
+
+	var v map[string]pingonetypes.ResourceIDValue
+	diags.Append(p.UserIds.ElementsAs(ctx, &v, false)...)
+
+	for username, userID := range v {
+		userIDs[username] = userID.ValueString()
+	}
+
+	return userIDs, diags
+}
+
+// toState sets the user IDs, and the content hash when records are provided
+func (p *UserBulkImportResourceModel) toState(ctx context.Context, records []userBulkImportRecord, userIDs map[string]string) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if userIDs == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot convert the data object to state as the data object is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	if records != nil {
+		p.ContentHash = framework.StringToTF(userBulkImportContentHash(records))
+	}
+
+	elements := make(map[string]pingonetypes.ResourceIDValue, len(userIDs))
+	for username, userID := range userIDs {
+		elements[username] = framework.PingOneResourceIDToTF(userID)
+	}
+
+	p.UserIds, d = types.MapValueFrom(ctx, pingonetypes.ResourceIDType{}, elements)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,419 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccUserBulkImport_Drift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_bulk_import.%s", resourceName)
+
+	name := resourceName
+
+	var environmentID, removedUserID, changedUserID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserBulkImport_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Test removal of an imported user
+			{
+				Config: testAccUserBulkImportConfig_CSV(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					sso.UserBulkImport_GetUserID(resourceFullName, fmt.Sprintf("%s-1", name), &environmentID, &removedUserID),
+					sso.UserBulkImport_GetUserID(resourceFullName, fmt.Sprintf("%s-2", name), nil, &changedUserID),
+				),
+			},
+			{
+				PreConfig: func() {
+					sso.User_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, removedUserID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check:              resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "2"),
+			},
+			// The removed user is re-imported
+			{
+				Config: testAccUserBulkImportConfig_CSV(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "3"),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-1", name), verify.P1ResourceIDRegexpFullString),
+				),
+			},
+			// Test change of an imported user outside of Terraform
+			{
+				PreConfig: func() {
+					sso.UserBulkImport_ChangedUser_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, changedUserID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccUserBulkImportConfig_CSV(resourceName, name),
+				Check:  resource.TestCheckResourceAttrPtr(resourceFullName, fmt.Sprintf("user_ids.%s-2", name), &changedUserID),
+			},
+		},
+	})
+}
+
+func TestAccUserBulkImport_CSV(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_bulk_import.%s", resourceName)
+
+	name := resourceName
+
+	var contentHash string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserBulkImport_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserBulkImportConfig_CSV(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttrPair(resourceFullName, "population_id", fmt.Sprintf("pingone_population.%s", resourceName), "id"),
+					resource.TestCheckResourceAttr(resourceFullName, "delete_users", "true"),
+					resource.TestMatchResourceAttr(resourceFullName, "content_hash", regexp.MustCompile(`^[a-f0-9]{64}$`)),
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "3"),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-1", name), verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-2", name), verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-3", name), verify.P1ResourceIDRegexpFullString),
+					func(s *terraform.State) error {
+						contentHash = s.RootModule().Resources[resourceFullName].Primary.Attributes["content_hash"]
+						return nil
+					},
+				),
+			},
+			// Change the source, removing one user and adding another
+			{
+				Config: testAccUserBulkImportConfig_CSVChanged(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-3", name)),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-4", name), verify.P1ResourceIDRegexpFullString),
+					func(s *terraform.State) error {
+						if v := s.RootModule().Resources[resourceFullName].Primary.Attributes["content_hash"]; v == contentHash {
+							return fmt.Errorf("expected the content hash to change, got %s", v)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserBulkImport_JSONL(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_bulk_import.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserBulkImport_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserBulkImportConfig_JSONL(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "content_hash", regexp.MustCompile(`^[a-f0-9]{64}$`)),
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "2"),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-1", name), verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-2", name), verify.P1ResourceIDRegexpFullString),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserBulkImport_Users(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_bulk_import.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserBulkImport_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserBulkImportConfig_Users(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "users.#", "2"),
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "2"),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-1", name), verify.P1ResourceIDRegexpFullString),
+					resource.TestMatchResourceAttr(resourceFullName, fmt.Sprintf("user_ids.%s-2", name), verify.P1ResourceIDRegexpFullString),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserBulkImport_UpsertExistingUser(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_bulk_import.%s", resourceName)
+
+	name := resourceName
+
+	var environmentID, populationID, existingUserID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserBulkImport_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserBulkImportConfig_Population(resourceName, name),
+				Check:  sso.Population_GetIDs(fmt.Sprintf("pingone_population.%s", resourceName), &environmentID, &populationID),
+			},
+			// A user with the same username already exists, and is updated in place
+			{
+				PreConfig: func() {
+					sso.User_CreateUser_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, fmt.Sprintf("%s-1", name), &existingUserID, nil)
+				},
+				Config: testAccUserBulkImportConfig_CSV(resourceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "user_ids.%", "3"),
+					resource.TestCheckResourceAttrPtr(resourceFullName, fmt.Sprintf("user_ids.%s-1", name), &existingUserID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserBulkImport_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserBulkImport_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserBulkImportConfig_InvalidCSV(resourceName, name),
+				ExpectError: regexp.MustCompile(`Invalid CSV content`),
+			},
+			{
+				Config:      testAccUserBulkImportConfig_InvalidJSONL(resourceName, name),
+				ExpectError: regexp.MustCompile(`Invalid JSONL content`),
+			},
+			{
+				Config:      testAccUserBulkImportConfig_DuplicateUsers(resourceName, name),
+				ExpectError: regexp.MustCompile(`Invalid users`),
+			},
+		},
+	})
+}
+
+func testAccUserBulkImportConfig_Population(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_CSV(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  csv_content = <<-EOT
+    username,email,given_name,family_name
+    %[3]s-1,%[3]s-1@pingidentity.com,Test,One
+    %[3]s-2,%[3]s-2@pingidentity.com,Test,Two
+    %[3]s-3,%[3]s-3@pingidentity.com,Test,Three
+  EOT
+
+  delete_users = true
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_CSVChanged(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  csv_content = <<-EOT
+    username,email,given_name,family_name,external_id
+    %[3]s-1,%[3]s-1@pingidentity.com,Test,One,ext-1
+    %[3]s-2,%[3]s-2-updated@pingidentity.com,Test,Two,ext-2
+    %[3]s-4,%[3]s-4@pingidentity.com,Test,Four,ext-4
+  EOT
+
+  delete_users = true
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_JSONL(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  jsonl_content = <<-EOT
+    {"username": "%[3]s-1", "email": "%[3]s-1@pingidentity.com", "given_name": "Test", "family_name": "One"}
+    {"username": "%[3]s-2", "email": "%[3]s-2@pingidentity.com", "password_hash": "{SSHA512}UkGWfORubNKFpFBWh+Lgy4FrciclzUXneuryV+B+zBDR4Gqd5wvMqAvKRixgQWoZlZUgq8Wh40uMK3s6bWpzWt1/TqQH02hX"}
+  EOT
+
+  delete_users = true
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_Users(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  users = [
+    {
+      username    = "%[3]s-1"
+      email       = "%[3]s-1@pingidentity.com"
+      given_name  = "Test"
+      family_name = "One"
+    },
+    {
+      username    = "%[3]s-2"
+      email       = "%[3]s-2@pingidentity.com"
+      external_id = "ext-2"
+    },
+  ]
+
+  delete_users = true
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_InvalidCSV(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  csv_content = <<-EOT
+    username,given_name
+    %[3]s-1,Test
+  EOT
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_InvalidJSONL(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  jsonl_content = <<-EOT
+    {"username": "%[3]s-1", "email": "%[3]s-1@pingidentity.com", "unsupported": true}
+  EOT
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
+
+func testAccUserBulkImportConfig_DuplicateUsers(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_bulk_import" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  population_id  = pingone_population.%[2]s.id
+
+  users = [
+    {
+      username = "%[3]s-1"
+      email    = "%[3]s-1@pingidentity.com"
+    },
+    {
+      username = "%[3]s-1"
+      email    = "%[3]s-1-duplicate@pingidentity.com"
+    },
+  ]
+}`, testAccUserBulkImportConfig_Population(resourceName, name), resourceName, name)
+}
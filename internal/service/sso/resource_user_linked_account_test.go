@@ -0,0 +1,274 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest"
+	acctestlegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/legacysdk"
+	baselegacysdk "github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/base/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/acctest/service/sso"
+	client "github.com/pingidentity/terraform-provider-pingone/internal/client"
+	"github.com/pingidentity/terraform-provider-pingone/internal/verify"
+)
+
+func TestAccUserLinkedAccount_RemovalDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_linked_account.%s", resourceName)
+
+	environmentName := acctest.ResourceNameGenEnvironment()
+
+	name := resourceName
+
+	licenseID := os.Getenv("PINGONE_LICENSE_ID")
+
+	var linkedAccountID, userID, environmentID string
+
+	var p1Client *client.Client
+	var ctx = context.Background()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNewEnvironment(t)
+			acctest.PreCheckNoBeta(t)
+			p1Client = acctestlegacysdk.PreCheckTestClient(ctx, t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserLinkedAccount_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccUserLinkedAccountConfig_Full(resourceName, name),
+				Check:  sso.UserLinkedAccount_GetIDs(resourceFullName, &environmentID, &userID, &linkedAccountID),
+			},
+			// Test removal of the linked account
+			{
+				PreConfig: func() {
+					sso.UserLinkedAccount_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, userID, linkedAccountID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the user
+			{
+				Config: testAccUserLinkedAccountConfig_Full(resourceName, name),
+				Check:  sso.UserLinkedAccount_GetIDs(resourceFullName, &environmentID, &userID, &linkedAccountID),
+			},
+			{
+				PreConfig: func() {
+					sso.User_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID, userID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test removal of the environment
+			{
+				Config: testAccUserLinkedAccountConfig_NewEnv(environmentName, licenseID, resourceName, name),
+				Check:  sso.UserLinkedAccount_GetIDs(resourceFullName, &environmentID, &userID, &linkedAccountID),
+			},
+			{
+				PreConfig: func() {
+					baselegacysdk.Environment_RemovalDrift_PreConfig(ctx, p1Client.API.ManagementAPIClient, t, environmentID)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccUserLinkedAccount_Full(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_linked_account.%s", resourceName)
+
+	name := resourceName
+
+	check := func(externalID string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+			resource.TestMatchResourceAttr(resourceFullName, "environment_id", verify.P1ResourceIDRegexpFullString),
+			resource.TestCheckResourceAttrPair(resourceFullName, "user_id", fmt.Sprintf("pingone_user.%s", resourceName), "id"),
+			resource.TestCheckResourceAttrPair(resourceFullName, "identity_provider_id", fmt.Sprintf("pingone_identity_provider.%s", resourceName), "id"),
+			resource.TestCheckResourceAttr(resourceFullName, "identity_provider_type", "GOOGLE"),
+			resource.TestCheckResourceAttr(resourceFullName, "external_id", externalID),
+			resource.TestMatchResourceAttr(resourceFullName, "created_at", verify.RFC3339Regexp),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserLinkedAccount_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserLinkedAccountConfig_Full(resourceName, name),
+				Check:  check("external-user-1"),
+			},
+			// Changing the external ID replaces the link
+			{
+				Config: testAccUserLinkedAccountConfig_ExternalId(resourceName, name, "external-user-2"),
+				Check:  check("external-user-2"),
+			},
+			// Test importing the resource
+			{
+				ResourceName: resourceFullName,
+				ImportStateIdFunc: func() resource.ImportStateIdFunc {
+					return func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceFullName]
+						if !ok {
+							return "", fmt.Errorf("resource not found: %s", resourceFullName)
+						}
+
+						return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["environment_id"], rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+					}
+				}(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccUserLinkedAccount_BadParameters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user_linked_account.%s", resourceName)
+
+	name := resourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.UserLinkedAccount_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Configure
+			{
+				Config: testAccUserLinkedAccountConfig_Full(resourceName, name),
+			},
+			// Errors
+			{
+				ResourceName: resourceFullName,
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "/",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  resourceFullName,
+				ImportStateId: "badformat/badformat/badformat",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func testAccUserLinkedAccountConfig_NewEnv(environmentName, licenseID, resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_population" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  name = "%[4]s"
+}
+
+resource "pingone_user" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+
+  username      = "%[4]s"
+  email         = "%[4]s@pingidentity.com"
+  population_id = pingone_population.%[3]s.id
+}
+
+resource "pingone_identity_provider" "%[3]s" {
+  environment_id = pingone_environment.%[2]s.id
+  name           = "%[4]s"
+
+  google = {
+    client_id     = "testclientid"
+    client_secret = "testclientsecret"
+  }
+}
+
+resource "pingone_user_linked_account" "%[3]s" {
+  environment_id       = pingone_environment.%[2]s.id
+  user_id              = pingone_user.%[3]s.id
+  identity_provider_id = pingone_identity_provider.%[3]s.id
+  external_id          = "external-user-1"
+}`, acctestlegacysdk.MinimalSandboxEnvironment(environmentName, licenseID), environmentName, resourceName, name)
+}
+
+func testAccUserLinkedAccountConfig_Base(resourceName, name string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s"
+  email         = "%[3]s@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+}
+
+resource "pingone_identity_provider" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+  name           = "%[3]s"
+
+  google = {
+    client_id     = "testclientid"
+    client_secret = "testclientsecret"
+  }
+}`, acctest.GenericSandboxEnvironment(), resourceName, name)
+}
+
+func testAccUserLinkedAccountConfig_Full(resourceName, name string) string {
+	return testAccUserLinkedAccountConfig_ExternalId(resourceName, name, "external-user-1")
+}
+
+func testAccUserLinkedAccountConfig_ExternalId(resourceName, name, externalID string) string {
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_user_linked_account" "%[2]s" {
+  environment_id       = data.pingone_environment.general_test.id
+  user_id              = pingone_user.%[2]s.id
+  identity_provider_id = pingone_identity_provider.%[2]s.id
+  external_id          = "%[3]s"
+}`, testAccUserLinkedAccountConfig_Base(resourceName, name), resourceName, externalID)
+}
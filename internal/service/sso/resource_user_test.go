@@ -549,6 +549,84 @@ func TestAccUser_ChangeUsernameAndEmail(t *testing.T) {
 	})
 }
 
+func TestAccUser_CustomAttributes(t *testing.T) {
+	t.Parallel()
+
+	resourceName := acctest.ResourceNameGen()
+	resourceFullName := fmt.Sprintf("pingone_user.%s", resourceName)
+
+	name := resourceName
+
+	fullCustomAttributes := fmt.Sprintf(`{"%[1]sCode":"ABC-1234","%[1]sPreferences":{"theme":"dark"},"%[1]sTags":["blue","green"],"%[1]sTier":"gold"}`, name)
+	minimalCustomAttributes := fmt.Sprintf(`{"%[1]sTier":"silver"}`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheckNoTestAccFlaky(t)
+			acctest.PreCheckClient(t)
+			acctest.PreCheckNoBeta(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             sso.User_CheckDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t),
+		Steps: []resource.TestStep{
+			// Full
+			{
+				Config: testAccUserConfig_CustomAttributes(resourceName, name, fullCustomAttributes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceFullName, "id", verify.P1ResourceIDRegexpFullString),
+					resource.TestCheckResourceAttr(resourceFullName, "custom_attributes", fullCustomAttributes),
+				),
+			},
+			// Change
+			{
+				Config: testAccUserConfig_CustomAttributes(resourceName, name, minimalCustomAttributes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "custom_attributes", minimalCustomAttributes),
+				),
+			},
+			{
+				Config: testAccUserConfig_CustomAttributes(resourceName, name, fullCustomAttributes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFullName, "custom_attributes", fullCustomAttributes),
+				),
+			},
+			// Unmanaged
+			{
+				Config: testAccUserConfig_CustomAttributes(resourceName, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceFullName, "custom_attributes"),
+				),
+			},
+			// Errors
+			{
+				Config:      testAccUserConfig_CustomAttributes(resourceName, name, fmt.Sprintf(`{"%[1]sTier":"platinum"}`, name)),
+				ExpectError: regexp.MustCompile(`is not one of the allowed enumerated values`),
+			},
+			{
+				Config:      testAccUserConfig_CustomAttributes(resourceName, name, fmt.Sprintf(`{"%[1]sCode":"abc"}`, name)),
+				ExpectError: regexp.MustCompile(`does not match the configured regular expression`),
+			},
+			{
+				Config:      testAccUserConfig_CustomAttributes(resourceName, name, fmt.Sprintf(`{"%[1]sTags":"blue"}`, name)),
+				ExpectError: regexp.MustCompile(`is multi-valued, so its value must be an array`),
+			},
+			{
+				Config:      testAccUserConfig_CustomAttributes(resourceName, name, fmt.Sprintf(`{"%[1]sTier":["gold"]}`, name)),
+				ExpectError: regexp.MustCompile(`is not multi-valued, so its value must not be an array`),
+			},
+			{
+				Config:      testAccUserConfig_CustomAttributes(resourceName, name, fmt.Sprintf(`{"%[1]sUnknown":"value"}`, name)),
+				ExpectError: regexp.MustCompile(`Unknown custom attribute`),
+			},
+			{
+				Config:      testAccUserConfig_CustomAttributes(resourceName, name, `["value"]`),
+				ExpectError: regexp.MustCompile(`Invalid custom attributes`),
+			},
+		},
+	})
+}
+
 func TestAccUser_BadParameters(t *testing.T) {
 	t.Parallel()
 
@@ -889,3 +967,79 @@ resource "pingone_user" "%[2]s" {
   population_id = pingone_population.%[2]s-new.id
 }`, acctest.GenericSandboxEnvironment(), resourceName, name)
 }
+
+func testAccUserConfig_CustomAttributes(resourceName, name, customAttributes string) string {
+	customAttributesHCL := ""
+	if customAttributes != "" {
+		customAttributesHCL = fmt.Sprintf("custom_attributes = %q", customAttributes)
+	}
+
+	return fmt.Sprintf(`
+		%[1]s
+
+resource "pingone_schema_attribute" "%[2]s-tier" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]sTier"
+  type = "STRING"
+
+  enumerated_values = [
+    {
+      value = "gold"
+    },
+    {
+      value = "silver"
+    }
+  ]
+}
+
+resource "pingone_schema_attribute" "%[2]s-tags" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name        = "%[3]sTags"
+  type        = "STRING"
+  multivalued = true
+}
+
+resource "pingone_schema_attribute" "%[2]s-code" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]sCode"
+  type = "STRING"
+
+  regex_validation = {
+    pattern      = "^[A-Z]{3}-[0-9]{4}$"
+    requirements = "Must be three upper case letters, a hyphen and four digits"
+  }
+}
+
+resource "pingone_schema_attribute" "%[2]s-preferences" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]sPreferences"
+  type = "JSON"
+}
+
+resource "pingone_population" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  name = "%[3]s"
+}
+
+resource "pingone_user" "%[2]s" {
+  environment_id = data.pingone_environment.general_test.id
+
+  username      = "%[3]s"
+  email         = "%[3]s@pingidentity.com"
+  population_id = pingone_population.%[2]s.id
+
+  %[4]s
+
+  depends_on = [
+    pingone_schema_attribute.%[2]s-tier,
+    pingone_schema_attribute.%[2]s-tags,
+    pingone_schema_attribute.%[2]s-code,
+    pingone_schema_attribute.%[2]s-preferences,
+  ]
+}`, acctest.GenericSandboxEnvironment(), resourceName, name, customAttributesHCL)
+}
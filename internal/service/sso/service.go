@@ -17,12 +17,14 @@ func Resources() []func() resource.Resource {
 		NewAdministratorSecurityResource,
 		NewApplicationAttributeMappingResource,
 		NewApplicationFlowPolicyAssignmentResource,
+		NewApplicationAccessControlResource,
 		NewApplicationResource,
 		NewApplicationResourceGrantResource,
 		NewApplicationResourceResource,
 		NewApplicationRoleAssignmentResource,
 		NewApplicationSecretResource,
 		NewCustomRoleResource,
+		NewGroupMembersResource,
 		NewGroupNestingResource,
 		NewGroupResource,
 		NewGroupRoleAssignmentResource,
@@ -41,7 +43,9 @@ func Resources() []func() resource.Resource {
 		NewSchemaAttributeResource,
 		NewSignOnPolicyResource,
 		NewUserApplicationRoleAssignmentResource,
+		NewUserBulkImportResource,
 		NewUserGroupAssignmentResource,
+		NewUserLinkedAccountResource,
 		NewUserResource,
 	}
 	resources = append(resources, BetaResources()...)
@@ -66,15 +70,18 @@ func DataSources() []func() datasource.DataSource {
 		NewGroupsDataSource,
 		NewPasswordPoliciesDataSource,
 		NewPasswordPolicyDataSource,
+		NewPasswordPolicyEvaluationDataSource,
 		NewPopulationDataSource,
-		NewPopulationsDataSource,
+		NewPopulationsWithDetailsDataSource,
 		NewResourceDataSource,
 		NewResourceScopeDataSource,
 		NewResourceScopesDataSource,
 		NewResourceSecretDataSource,
 		NewSchemaDataSource,
 		NewSchemaAttributeDataSource,
+		NewSchemaAttributeUniquenessCheckDataSource,
 		NewUserDataSource,
+		NewUserLinkedAccountDataSource,
 		NewUsersDataSource,
 	}
 	dataSources = append(dataSources, BetaDataSources()...)
@@ -0,0 +1,609 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/filter"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+const (
+	userBulkImportContentType = "application/vnd.pingidentity.user.import+json"
+
+	// The number of users written to the platform concurrently
+	userBulkImportConcurrency = 10
+
+	// The number of per-row failures reported individually before the remainder are summarised
+	userBulkImportMaxRowDiagnostics = 25
+)
+
+var userBulkImportColumns = []string{"username", "email", "given_name", "family_name", "external_id", "password_hash"}
+
+// userBulkImportRecord is a single user row from the CSV, JSONL or inline source.  Row is the line number in the source
+// content, or the position in the inline list.
+type userBulkImportRecord struct {
+	Row          int    `json:"-"`
+	Username     string `json:"username"`
+	Email        string `json:"email"`
+	GivenName    string `json:"given_name,omitempty"`
+	FamilyName   string `json:"family_name,omitempty"`
+	ExternalId   string `json:"external_id,omitempty"`
+	PasswordHash string `json:"password_hash,omitempty"`
+}
+
+func parseUserBulkImportCSV(content string) ([]userBulkImportRecord, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("the CSV content must start with a header row")
+		}
+		return nil, fmt.Errorf("cannot read the CSV header row: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+
+		if !isUserBulkImportColumn(column) {
+			return nil, fmt.Errorf("the CSV header contains an unsupported column %q.  Supported columns are: %s", column, strings.Join(userBulkImportColumns, ", "))
+		}
+
+		if _, ok := columns[column]; ok {
+			return nil, fmt.Errorf("the CSV header contains the column %q more than once", column)
+		}
+
+		columns[column] = i
+	}
+
+	for _, column := range []string{"username", "email"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("the CSV header must contain the %q column", column)
+		}
+	}
+
+	value := func(row []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	records := make([]userBulkImportRecord, 0)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the CSV content: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		records = append(records, userBulkImportRecord{
+			Row:          line,
+			Username:     value(row, "username"),
+			Email:        value(row, "email"),
+			GivenName:    value(row, "given_name"),
+			FamilyName:   value(row, "family_name"),
+			ExternalId:   value(row, "external_id"),
+			PasswordHash: value(row, "password_hash"),
+		})
+	}
+
+	return records, validateUserBulkImportRecords(records)
+}
+
+func parseUserBulkImportJSONL(content string) ([]userBulkImportRecord, error) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	records := make([]userBulkImportRecord, 0)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader([]byte(text)))
+		decoder.DisallowUnknownFields()
+
+		var record userBulkImportRecord
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("cannot parse line %d of the JSONL content: %w.  Supported keys are: %s", line, err, strings.Join(userBulkImportColumns, ", "))
+		}
+
+		record.Row = line
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read the JSONL content: %w", err)
+	}
+
+	return records, validateUserBulkImportRecords(records)
+}
+
+func validateUserBulkImportRecords(records []userBulkImportRecord) error {
+	usernames := make(map[string]int, len(records))
+
+	for _, record := range records {
+		if record.Username == "" {
+			return fmt.Errorf("row %d does not have a username", record.Row)
+		}
+
+		if record.Email == "" {
+			return fmt.Errorf("row %d (username %q) does not have an email", record.Row, record.Username)
+		}
+
+		key := strings.ToLower(record.Username)
+		if row, ok := usernames[key]; ok {
+			return fmt.Errorf("row %d has the same username as row %d (%q).  Usernames must be unique in the source", record.Row, row, record.Username)
+		}
+		usernames[key] = record.Row
+	}
+
+	return nil
+}
+
+func isUserBulkImportColumn(column string) bool {
+	for _, v := range userBulkImportColumns {
+		if v == column {
+			return true
+		}
+	}
+	return false
+}
+
+// userBulkImportContentHash returns a hash of the records' user data.  Password hashes are excluded as they cannot be read
+// back from the platform, and the hash is independent of row order.
+func userBulkImportContentHash(records []userBulkImportRecord) string {
+	sorted := make([]userBulkImportRecord, len(records))
+	copy(sorted, records)
+
+	for i := range sorted {
+		sorted[i].PasswordHash = ""
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Username) < strings.ToLower(sorted[j].Username)
+	})
+
+	hash := sha256.New()
+	encoder := json.NewEncoder(hash)
+	for _, record := range sorted {
+		_ = encoder.Encode(record)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// observedUserBulkImportRecord returns the record as it is stored on the platform, limited to the fields set in the source
+// record so that attributes managed outside of the import are not reported as drift.
+func observedUserBulkImportRecord(record userBulkImportRecord, user management.User) userBulkImportRecord {
+	observed := userBulkImportRecord{
+		Row:      record.Row,
+		Username: user.GetUsername(),
+		Email:    user.GetEmail(),
+	}
+
+	if strings.EqualFold(observed.Username, record.Username) {
+		observed.Username = record.Username
+	}
+
+	name, nameOk := user.GetNameOk()
+
+	if record.GivenName != "" && nameOk {
+		observed.GivenName = name.GetGiven()
+	}
+
+	if record.FamilyName != "" && nameOk {
+		observed.FamilyName = name.GetFamily()
+	}
+
+	if record.ExternalId != "" {
+		observed.ExternalId = user.GetExternalId()
+	}
+
+	return observed
+}
+
+func (r userBulkImportRecord) expand(populationID string, includePassword bool) *management.User {
+	user := management.NewUser(r.Email, r.Username)
+
+	if populationID != "" {
+		user.SetPopulation(*management.NewUserPopulation(populationID))
+	}
+
+	if r.GivenName != "" || r.FamilyName != "" {
+		name := management.NewUserName()
+
+		if r.GivenName != "" {
+			name.SetGiven(r.GivenName)
+		}
+
+		if r.FamilyName != "" {
+			name.SetFamily(r.FamilyName)
+		}
+
+		user.SetName(*name)
+	}
+
+	if r.ExternalId != "" {
+		user.SetExternalId(r.ExternalId)
+	}
+
+	// Pre-encoded password hashes are set on password.value, which the import content type accepts in an encoded format.  The
+	// password.external object is not used, as it only references an external gateway directory that manages the password, and
+	// has no property that can carry a password hash.
+	if includePassword && r.PasswordHash != "" {
+		password := management.NewUserPassword()
+		password.SetValue(r.PasswordHash)
+		password.SetForceChange(false)
+		user.SetPassword(*password)
+	}
+
+	return user
+}
+
+// importUserBulkImportRecords upserts the records by username.  Users with a known ID are updated, otherwise they are
+// created, falling back to an update of the existing user with the same username.  The returned map contains the IDs of
+// the users that were written, and of any known users that could not be written.
+func importUserBulkImportRecords(ctx context.Context, apiClient *management.APIClient, environmentID, populationID string, records []userBulkImportRecord, knownUserIDs map[string]string) (map[string]string, diag.Diagnostics) {
+	userIDs := make([]string, len(records))
+	rowDiags := make([]diag.Diagnostics, len(records))
+
+	var wg sync.WaitGroup
+	work := make(chan int)
+
+	for range userBulkImportConcurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range work {
+				userIDs[i], rowDiags[i] = importUserBulkImportRecord(ctx, apiClient, environmentID, populationID, records[i], knownUserIDs[records[i].Username])
+			}
+		}()
+	}
+
+	for i := range records {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	result := make(map[string]string, len(records))
+	for i, record := range records {
+		if userIDs[i] != "" {
+			result[record.Username] = userIDs[i]
+		} else if v, ok := knownUserIDs[record.Username]; ok {
+			result[record.Username] = v
+		}
+	}
+
+	return result, userBulkImportRowDiagnostics(records, rowDiags)
+}
+
+func importUserBulkImportRecord(ctx context.Context, apiClient *management.APIClient, environmentID, populationID string, record userBulkImportRecord, userID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	knownUser := userID != ""
+
+	if !knownUser {
+		var createdUser *management.User
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := createUserBulkImportUser(ctx, apiClient, environmentID, *record.expand(populationID, true))
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, fO, fR, fErr)
+			},
+			"CreateUser",
+			legacysdk.DefaultCustomError,
+			sdk.DefaultCreateReadRetryable,
+			&createdUser,
+		)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		if createdUser != nil {
+			return createdUser.GetId(), diags
+		}
+
+		// A user with the same username already exists
+		userID, diags = findUserBulkImportUserID(ctx, apiClient, environmentID, record.Username)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	var updatedUser *management.User
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fO, fR, fErr := updateUserBulkImportUser(ctx, apiClient, environmentID, userID, *record.expand("", false))
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, fO, fR, fErr)
+		},
+		"UpdateUserPatch",
+		legacysdk.DefaultCustomError,
+		nil,
+		&updatedUser,
+	)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if updatedUser == nil {
+		// The user has been removed since it was last imported
+		if knownUser {
+			return importUserBulkImportRecord(ctx, apiClient, environmentID, populationID, record, "")
+		}
+
+		diags.AddError(
+			"Cannot find user",
+			fmt.Sprintf("The user with username %q was removed while it was being imported.", record.Username),
+		)
+		return "", diags
+	}
+
+	if population, ok := updatedUser.GetPopulationOk(); !ok || population.GetId() != populationID {
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fO, fR, fErr := apiClient.UserPopulationsApi.UpdateUserPopulation(ctx, environmentID, userID).UserPopulation(*management.NewUserPopulation(populationID)).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, fO, fR, fErr)
+			},
+			"UpdateUserPopulation",
+			legacysdk.DefaultCustomError,
+			nil,
+			nil,
+		)...)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	return userID, diags
+}
+
+// userBulkImportRowDiagnostics attributes each row's diagnostics to the row, limiting the number of rows reported individually.
+// Row failures are warnings where at least one row was imported, so that the imported users are saved to state rather than the resource being tainted, and are errors where no row was imported.
+func userBulkImportRowDiagnostics(records []userBulkImportRecord, rowDiags []diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	failedRows := 0
+	for i := range records {
+		if rowDiags[i].HasError() {
+			failedRows++
+		}
+	}
+
+	addDiagnostic := diags.AddWarning
+	if failedRows == len(records) {
+		addDiagnostic = diags.AddError
+	}
+
+	reportedRows := 0
+	for i, record := range records {
+		if !rowDiags[i].HasError() {
+			continue
+		}
+
+		reportedRows++
+		if reportedRows > userBulkImportMaxRowDiagnostics {
+			break
+		}
+
+		details := make([]string, 0)
+		for _, d := range rowDiags[i].Errors() {
+			details = append(details, strings.TrimSpace(fmt.Sprintf("%s\n%s", d.Summary(), d.Detail())))
+		}
+
+		addDiagnostic(
+			fmt.Sprintf("Cannot import user on row %d", record.Row),
+			fmt.Sprintf("The user with username %q could not be imported.\n\n%s", record.Username, strings.Join(details, "\n\n")),
+		)
+	}
+
+	if failedRows > userBulkImportMaxRowDiagnostics {
+		addDiagnostic(
+			"Cannot import further users",
+			fmt.Sprintf("%d further rows could not be imported.  Only the first %d failures are reported individually.", failedRows-userBulkImportMaxRowDiagnostics, userBulkImportMaxRowDiagnostics),
+		)
+	}
+
+	return diags
+}
+
+// createUserBulkImportUser returns a nil user, without error, when a user with the same username already exists
+func createUserBulkImportUser(ctx context.Context, apiClient *management.APIClient, environmentID string, user management.User) (any, *http.Response, error) {
+	fO, fR, fErr := apiClient.UsersApi.CreateUser(ctx, environmentID).ContentType(userBulkImportContentType).User(user).Execute()
+	if isUserUniquenessViolation(fErr) {
+		return nil, nil, nil
+	}
+
+	return fO, fR, fErr
+}
+
+// updateUserBulkImportUser returns a nil user, without error, when the user cannot be found
+func updateUserBulkImportUser(ctx context.Context, apiClient *management.APIClient, environmentID, userID string, user management.User) (any, *http.Response, error) {
+	fO, fR, fErr := apiClient.UsersApi.UpdateUserPatch(ctx, environmentID, userID).User(user).Execute()
+	if fR != nil && fR.StatusCode == http.StatusNotFound {
+		return nil, nil, nil
+	}
+
+	return fO, fR, fErr
+}
+
+func isUserUniquenessViolation(err error) bool {
+	var apiErr *management.GenericOpenAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	p1Error, ok := apiErr.Model().(management.P1Error)
+	if !ok {
+		return false
+	}
+
+	if details, ok := p1Error.GetDetailsOk(); ok {
+		for _, detail := range details {
+			if detail.GetCode() == "UNIQUENESS_VIOLATION" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func findUserBulkImportUserID(ctx context.Context, apiClient *management.APIClient, environmentID, username string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scimFilter := filter.BuildScimFilter(
+		append(make([]interface{}, 0), map[string]interface{}{
+			"name":   "username",
+			"values": []string{username},
+		}), map[string]string{})
+
+	var userID *string
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := apiClient.UsersApi.ReadAllUsers(ctx, environmentID).Filter(scimFilter).Execute()
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if users, ok := pageCursor.EntityArray.Embedded.GetUsersOk(); ok {
+					for _, u := range users {
+						if strings.EqualFold(u.GetUsername(), username) {
+							return u.Id, pageCursor.HTTPResponse, nil
+						}
+					}
+				}
+			}
+
+			return nil, initialHttpResponse, nil
+		},
+		"ReadAllUsers",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&userID,
+	)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if userID == nil {
+		diags.AddError(
+			"Cannot find user",
+			fmt.Sprintf("The platform reported that a user with username %q already exists, but the user cannot be found.", username),
+		)
+		return "", diags
+	}
+
+	return *userID, diags
+}
+
+// readUserBulkImportPopulationUsers returns the users in the population, keyed by user ID
+func readUserBulkImportPopulationUsers(ctx context.Context, apiClient *management.APIClient, environmentID, populationID string) (map[string]management.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scimFilter := fmt.Sprintf(`population.id eq "%s"`, populationID)
+
+	var users map[string]management.User
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := apiClient.UsersApi.ReadAllUsers(ctx, environmentID).Filter(scimFilter).Execute()
+
+			foundUsers := make(map[string]management.User)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if pageUsers, ok := pageCursor.EntityArray.Embedded.GetUsersOk(); ok {
+					for _, u := range pageUsers {
+						foundUsers[u.GetId()] = u
+					}
+				}
+			}
+
+			return foundUsers, initialHttpResponse, nil
+		},
+		"ReadAllUsers",
+		legacysdk.CustomErrorResourceNotFoundWarning,
+		sdk.DefaultCreateReadRetryable,
+		&users,
+	)...)
+
+	return users, diags
+}
+
+func deleteUserBulkImportUsers(ctx context.Context, apiClient *management.APIClient, environmentID string, userIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, userID := range userIDs {
+		diags.Append(legacysdk.ParseResponse(
+			ctx,
+
+			func() (any, *http.Response, error) {
+				fR, fErr := apiClient.UsersApi.DeleteUser(ctx, environmentID, userID).Execute()
+				return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, fR, fErr)
+			},
+			"DeleteUser",
+			legacysdk.CustomErrorResourceNotFoundWarning,
+			nil,
+			nil,
+		)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,420 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+	"github.com/pingidentity/terraform-provider-pingone/internal/sdk"
+)
+
+// The name of the PingOne schema that holds user attributes
+const userSchemaName = "User"
+
+// fetchUserCustomSchemaAttributes returns the enabled custom attributes of the environment's user schema, keyed by attribute name.
+func fetchUserCustomSchemaAttributes(ctx context.Context, apiClient *management.APIClient, environmentID string) (map[string]management.SchemaAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schema, d := fetchSchemaFromName(ctx, apiClient, environmentID, userSchemaName)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var schemaAttributes map[string]management.SchemaAttribute
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			pagedIterator := apiClient.SchemasApi.ReadAllSchemaAttributes(ctx, environmentID, schema.GetId()).Execute()
+
+			foundAttributes := make(map[string]management.SchemaAttribute)
+
+			var initialHttpResponse *http.Response
+
+			for pageCursor, err := range pagedIterator {
+				if err != nil {
+					return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, pageCursor.HTTPResponse, err)
+				}
+
+				if initialHttpResponse == nil {
+					initialHttpResponse = pageCursor.HTTPResponse
+				}
+
+				if attributes, ok := pageCursor.EntityArray.Embedded.GetAttributesOk(); ok {
+					for _, attribute := range attributes {
+						if attribute.SchemaAttribute == nil || !attribute.SchemaAttribute.GetEnabled() {
+							continue
+						}
+
+						if v, ok := attribute.SchemaAttribute.GetSchemaTypeOk(); ok && *v == management.ENUMSCHEMAATTRIBUTESCHEMATYPE_CUSTOM {
+							foundAttributes[attribute.SchemaAttribute.GetName()] = *attribute.SchemaAttribute
+						}
+					}
+				}
+			}
+
+			return foundAttributes, initialHttpResponse, nil
+		},
+		"ReadAllSchemaAttributes",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		&schemaAttributes,
+	)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return schemaAttributes, diags
+}
+
+// userCustomAttributesToTF reads the custom attribute values of a user from a raw user response, filtered to the environment's custom user schema attributes.
+func userCustomAttributesToTF(ctx context.Context, apiClient *management.APIClient, environmentID string, httpResponse *http.Response) (jsontypes.Normalized, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaAttributes, d := fetchUserCustomSchemaAttributes(ctx, apiClient, environmentID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return jsontypes.NewNormalizedNull(), diags
+	}
+
+	v, d := userCustomAttributesFromHTTPResponse(httpResponse, schemaAttributes)
+	diags.Append(d...)
+
+	return v, diags
+}
+
+// userCustomAttributesFromHTTPResponse extracts the custom attribute values from a raw user response, as the SDK user model does not carry them.
+func userCustomAttributesFromHTTPResponse(httpResponse *http.Response, schemaAttributes map[string]management.SchemaAttribute) (jsontypes.Normalized, diag.Diagnostics) {
+	var userAttributes map[string]any
+	diags := userResponseBodyUnmarshal(httpResponse, &userAttributes)
+	if diags.HasError() {
+		return jsontypes.NewNormalizedNull(), diags
+	}
+
+	v, d := userCustomAttributesFromUserAttributes(userAttributes, schemaAttributes)
+	diags.Append(d...)
+
+	return v, diags
+}
+
+// userCustomAttributesFromListHTTPResponse extracts the custom attribute values of each user in a raw user list page response, keyed by user ID.
+func userCustomAttributesFromListHTTPResponse(httpResponse *http.Response, schemaAttributes map[string]management.SchemaAttribute) (map[string]jsontypes.Normalized, diag.Diagnostics) {
+	var page struct {
+		Embedded struct {
+			Users []map[string]any `json:"users"`
+		} `json:"_embedded"`
+	}
+	diags := userResponseBodyUnmarshal(httpResponse, &page)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	customAttributes := make(map[string]jsontypes.Normalized, len(page.Embedded.Users))
+	for _, userAttributes := range page.Embedded.Users {
+		id, ok := userAttributes["id"].(string)
+		if !ok {
+			continue
+		}
+
+		v, d := userCustomAttributesFromUserAttributes(userAttributes, schemaAttributes)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		customAttributes[id] = v
+	}
+
+	return customAttributes, diags
+}
+
+func userCustomAttributesFromUserAttributes(userAttributes map[string]any, schemaAttributes map[string]management.SchemaAttribute) (jsontypes.Normalized, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	customAttributes := make(map[string]any)
+	for name, value := range userAttributes {
+		if _, ok := schemaAttributes[name]; ok {
+			customAttributes[name] = value
+		}
+	}
+
+	jsonBytes, err := json.Marshal(customAttributes)
+	if err != nil {
+		diags.AddError("Normalized JSON Type Conversion Error", err.Error())
+		return jsontypes.NewNormalizedNull(), diags
+	}
+
+	return jsontypes.NewNormalizedValue(string(jsonBytes)), diags
+}
+
+// userResponseBodyUnmarshal parses a raw user API response body.  The body is restored so that the response can be read again.
+func userResponseBodyUnmarshal(httpResponse *http.Response, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if httpResponse == nil || httpResponse.Body == nil {
+		diags.AddError(
+			"Data object missing",
+			"Cannot read the user custom attributes as the API response is nil.  Please report this to the provider maintainers.",
+		)
+
+		return diags
+	}
+
+	bodyBytes, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	httpResponse.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		diags.AddError(
+			"Cannot read user response",
+			fmt.Sprintf("The user API response body cannot be read: %s", err),
+		)
+
+		return diags
+	}
+
+	if err := json.Unmarshal(bodyBytes, v); err != nil {
+		diags.AddError(
+			"Cannot parse user response",
+			fmt.Sprintf("The user API response body cannot be parsed as JSON: %s", err),
+		)
+
+		return diags
+	}
+
+	return diags
+}
+
+// userCustomAttributesPatchBody builds the PATCH body that moves a user's custom attributes from the prior state to the plan.  Attributes removed from the plan are explicitly unset.
+func userCustomAttributesPatchBody(plan, state jsontypes.Normalized) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := make(map[string]any)
+
+	if !state.IsNull() && !state.IsUnknown() {
+		var stateAttributes map[string]any
+		diags.Append(state.Unmarshal(&stateAttributes)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for name := range stateAttributes {
+			body[name] = nil
+		}
+	}
+
+	if !plan.IsNull() && !plan.IsUnknown() {
+		var planAttributes map[string]any
+		diags.Append(plan.Unmarshal(&planAttributes)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for name, value := range planAttributes {
+			body[name] = value
+		}
+	}
+
+	return body, diags
+}
+
+// updateUserCustomAttributes patches the custom attribute values of a user.  The SDK user model cannot carry custom attributes, so the request is sent as a raw request.
+func updateUserCustomAttributes(ctx context.Context, apiClient *management.APIClient, environmentID, userID string, customAttributes map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(customAttributes) == 0 {
+		return diags
+	}
+
+	diags.Append(legacysdk.ParseResponse(
+		ctx,
+
+		func() (any, *http.Response, error) {
+			fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPatch, fmt.Sprintf("/environments/%s/users/%s", url.PathEscape(environmentID), url.PathEscape(userID)), "application/json", customAttributes, nil)
+			return legacysdk.CheckEnvironmentExistsOnPermissionsError(ctx, apiClient, environmentID, nil, fR, fErr)
+		},
+		"UpdateUserPatch",
+		legacysdk.DefaultCustomError,
+		sdk.DefaultCreateReadRetryable,
+		nil,
+	)...)
+
+	return diags
+}
+
+// validateUserCustomAttributes checks custom attribute values against the environment's user schema.  Checks that cannot be performed locally, such as uniqueness, are left to the platform.
+// When allowUnknownAttributes is set, attributes missing from the schema only raise a warning, as they may be created in the same plan.
+func validateUserCustomAttributes(attributePath path.Path, customAttributes map[string]any, schemaAttributes map[string]management.SchemaAttribute, allowUnknownAttributes bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(customAttributes))
+	for name := range customAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := customAttributes[name]
+
+		schemaAttribute, ok := schemaAttributes[name]
+		if !ok {
+			if allowUnknownAttributes {
+				diags.AddAttributeWarning(
+					attributePath,
+					"Unknown custom attribute",
+					fmt.Sprintf("The attribute \"%s\" is not currently an enabled custom attribute of the %s schema in this environment.  If the attribute is created in this plan, it will be validated when the user is applied.", name, userSchemaName),
+				)
+			} else {
+				diags.AddAttributeError(
+					attributePath,
+					"Unknown custom attribute",
+					fmt.Sprintf("The attribute \"%s\" is not an enabled custom attribute of the %s schema in this environment.", name, userSchemaName),
+				)
+			}
+			continue
+		}
+
+		if value == nil {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid custom attribute value",
+				fmt.Sprintf("The custom attribute \"%s\" must not be null.  Remove the attribute from the JSON to unset its value.", name),
+			)
+			continue
+		}
+
+		values := []any{value}
+		if items, ok := value.([]any); ok {
+			if !schemaAttribute.GetMultiValued() {
+				diags.AddAttributeError(
+					attributePath,
+					"Invalid custom attribute value",
+					fmt.Sprintf("The custom attribute \"%s\" is not multi-valued, so its value must not be an array.", name),
+				)
+				continue
+			}
+
+			values = items
+		} else if schemaAttribute.GetMultiValued() {
+			// The platform returns multi-valued attributes as arrays, so a single value would show as drift on the next read
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid custom attribute value",
+				fmt.Sprintf("The custom attribute \"%s\" is multi-valued, so its value must be an array.", name),
+			)
+			continue
+		}
+
+		for _, v := range values {
+			diags.Append(validateUserCustomAttributeValue(attributePath, name, v, schemaAttribute)...)
+		}
+	}
+
+	return diags
+}
+
+func validateUserCustomAttributeValue(attributePath path.Path, name string, value any, schemaAttribute management.SchemaAttribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch schemaAttribute.GetType() {
+	case management.ENUMSCHEMAATTRIBUTETYPE_STRING:
+		stringValue, ok := value.(string)
+		if !ok {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid custom attribute value",
+				fmt.Sprintf("The custom attribute \"%s\" is of type %s, so its value must be a string.", name, schemaAttribute.GetType()),
+			)
+			return diags
+		}
+
+		if enumeratedValues, ok := schemaAttribute.GetEnumeratedValuesOk(); ok && len(enumeratedValues) > 0 {
+			allowedValues := make([]string, 0, len(enumeratedValues))
+			for _, enumeratedValue := range enumeratedValues {
+				if !enumeratedValue.GetArchived() {
+					allowedValues = append(allowedValues, enumeratedValue.GetValue())
+				}
+			}
+
+			if !slices.Contains(allowedValues, stringValue) {
+				diags.AddAttributeError(
+					attributePath,
+					"Invalid custom attribute value",
+					fmt.Sprintf("The value \"%s\" of custom attribute \"%s\" is not one of the allowed enumerated values %v.", stringValue, name, allowedValues),
+				)
+			}
+		}
+
+		if regexValidation, ok := schemaAttribute.GetRegexValidationOk(); ok {
+			// PingOne patterns that are not supported by Go's regular expression syntax are validated by the platform on apply
+			if re, err := regexp.Compile(regexValidation.GetPattern()); err == nil && !re.MatchString(stringValue) {
+				diags.AddAttributeError(
+					attributePath,
+					"Invalid custom attribute value",
+					fmt.Sprintf("The value \"%s\" of custom attribute \"%s\" does not match the configured regular expression: %s", stringValue, name, regexValidation.GetRequirements()),
+				)
+			}
+		}
+
+	case management.ENUMSCHEMAATTRIBUTETYPE_BOOLEAN:
+		if _, ok := value.(bool); !ok {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid custom attribute value",
+				fmt.Sprintf("The custom attribute \"%s\" is of type %s, so its value must be a boolean.", name, schemaAttribute.GetType()),
+			)
+		}
+
+	case management.ENUMSCHEMAATTRIBUTETYPE_COMPLEX:
+		objectValue, ok := value.(map[string]any)
+		if !ok {
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid custom attribute value",
+				fmt.Sprintf("The custom attribute \"%s\" is of type %s, so its value must be an object.", name, schemaAttribute.GetType()),
+			)
+			return diags
+		}
+
+		subAttributes := make(map[string]management.SchemaAttribute)
+		for _, subAttribute := range schemaAttribute.GetSubAttributes() {
+			subAttributes[subAttribute.GetName()] = subAttribute
+		}
+
+		subNames := make([]string, 0, len(objectValue))
+		for subName := range objectValue {
+			subNames = append(subNames, subName)
+		}
+		sort.Strings(subNames)
+
+		for _, subName := range subNames {
+			subAttribute, ok := subAttributes[subName]
+			if !ok {
+				diags.AddAttributeError(
+					attributePath,
+					"Unknown custom attribute",
+					fmt.Sprintf("The attribute \"%s\" is not a sub-attribute of the custom attribute \"%s\".", subName, name),
+				)
+				continue
+			}
+
+			diags.Append(validateUserCustomAttributeValue(attributePath, fmt.Sprintf("%s.%s", name, subName), objectValue[subName], subAttribute)...)
+		}
+	}
+
+	return diags
+}
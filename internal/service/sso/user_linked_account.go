@@ -0,0 +1,100 @@
+// Copyright © 2026 Ping Identity Corporation
+
+package sso
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/patrickcping/pingone-go-sdk-v2/management"
+	"github.com/pingidentity/terraform-provider-pingone/internal/framework/legacysdk"
+)
+
+// The SDK's linked accounts operations do not return a model, and do not include a create operation, so the responses are decoded with these types.
+
+type userLinkedAccount struct {
+	Id               string                            `json:"id,omitempty"`
+	IdentityProvider userLinkedAccountIdentityProvider `json:"identityProvider"`
+	ExternalId       string                            `json:"externalId"`
+	CreatedAt        *time.Time                        `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time                        `json:"updatedAt,omitempty"`
+}
+
+type userLinkedAccountIdentityProvider struct {
+	Id   string  `json:"id"`
+	Type *string `json:"type,omitempty"`
+}
+
+type userLinkedAccountCollection struct {
+	Embedded struct {
+		LinkedAccounts []userLinkedAccount `json:"linkedAccounts"`
+	} `json:"_embedded"`
+}
+
+func createUserLinkedAccount(ctx context.Context, apiClient *management.APIClient, environmentID, userID string, linkedAccount userLinkedAccount) (any, *http.Response, error) {
+	var response userLinkedAccount
+	fR, fErr := legacysdk.ManagementAPIRawRequest(ctx, apiClient, http.MethodPost, fmt.Sprintf("/environments/%s/users/%s/linkedAccounts", url.PathEscape(environmentID), url.PathEscape(userID)), "", linkedAccount, &response)
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	return &response, fR, nil
+}
+
+func readUserLinkedAccount(ctx context.Context, apiClient *management.APIClient, environmentID, userID, linkedAccountID string) (any, *http.Response, error) {
+	fR, fErr := apiClient.LinkedAccountsApi.EnvironmentsEnvironmentIDUsersUserIDLinkedAccountsLinkedAccountIDGet(ctx, environmentID, userID, linkedAccountID).Execute()
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	var response userLinkedAccount
+	if err := decodeUserLinkedAccountResponse(fR, &response); err != nil {
+		return nil, fR, err
+	}
+
+	return &response, fR, nil
+}
+
+func readAllUserLinkedAccounts(ctx context.Context, apiClient *management.APIClient, environmentID, userID string) (any, *http.Response, error) {
+	fR, fErr := apiClient.LinkedAccountsApi.EnvironmentsEnvironmentIDUsersUserIDLinkedAccountsGet(ctx, environmentID, userID).Execute()
+	if fErr != nil {
+		return nil, fR, fErr
+	}
+
+	var response userLinkedAccountCollection
+	if err := decodeUserLinkedAccountResponse(fR, &response); err != nil {
+		return nil, fR, err
+	}
+
+	linkedAccounts := response.Embedded.LinkedAccounts
+	if linkedAccounts == nil {
+		linkedAccounts = make([]userLinkedAccount, 0)
+	}
+
+	return linkedAccounts, fR, nil
+}
+
+func decodeUserLinkedAccountResponse(httpResponse *http.Response, target any) error {
+	if httpResponse == nil || httpResponse.Body == nil {
+		return fmt.Errorf("the linked accounts response has no body")
+	}
+
+	body, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	httpResponse.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("cannot decode the linked accounts response: %w", err)
+	}
+
+	return nil
+}
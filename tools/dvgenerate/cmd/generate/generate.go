@@ -10,6 +10,11 @@ import (
 
 func main() {
 
+	if len(os.Args) > 1 && os.Args[1] == "flow" {
+		generateFlow(os.Args[2:])
+		return
+	}
+
 	var jsonFile string
 	flag.StringVar(&jsonFile, "file", "", "The path to the JSON file containing the connector schema.")
 	flag.Parse()
@@ -27,3 +32,31 @@ func main() {
 
 	dvgenerate.Generate(input)
 }
+
+func generateFlow(args []string) {
+
+	flowFlags := flag.NewFlagSet("flow", flag.ExitOnError)
+
+	var jsonFile, outputDirectory string
+	var overwrite bool
+	flowFlags.StringVar(&jsonFile, "file", "", "The path to the JSON file containing the DaVinci flow export.")
+	flowFlags.StringVar(&outputDirectory, "out", ".", "The directory to write the generated HCL files to.")
+	flowFlags.BoolVar(&overwrite, "overwrite", false, "Overwrite generated files that already exist in the output directory.")
+	_ = flowFlags.Parse(args)
+
+	if jsonFile == "" {
+		fmt.Println("Error: The -file flag is required.")
+		flowFlags.Usage()
+		os.Exit(1)
+	}
+
+	input, err := os.ReadFile(jsonFile)
+	if err != nil {
+		panic(fmt.Errorf("error reading specified file %s: %w", jsonFile, err))
+	}
+
+	if err := dvgenerate.GenerateFlow(input, outputDirectory, overwrite); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,467 @@
+package dvgenerate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/pingidentity/terraform-provider-pingone/dvgenerate/internal"
+)
+
+type flowConnectorInstanceData struct {
+	Id           string
+	ResourceName string
+	ConnectorId  string
+	Name         string
+}
+
+type flowVariableData struct {
+	ResourceName string
+	Context      string
+	Body         string
+}
+
+type flowFlowData struct {
+	Id           string
+	ResourceName string
+	Body         string
+	DependsOn    []string
+}
+
+type flowInputVariableData struct {
+	Name        string
+	Description string
+}
+
+// The pingone_davinci_flow attributes, mapped from the flow export
+var flowPositionAttributes = []hclAttribute{
+	{Name: "x", Key: "x"},
+	{Name: "y", Key: "y"},
+}
+
+var flowElementAttributes = []hclAttribute{
+	{Name: "classes", Key: "classes"},
+	{Name: "grabbable", Key: "grabbable"},
+	{Name: "group", Key: "group"},
+	{Name: "locked", Key: "locked"},
+	{Name: "pannable", Key: "pannable"},
+	{Name: "position", Key: "position", Kind: hclAttributeKindObject, Nested: flowPositionAttributes},
+	{Name: "removed", Key: "removed"},
+	{Name: "selectable", Key: "selectable"},
+	{Name: "selected", Key: "selected"},
+}
+
+var flowAttributes = []hclAttribute{
+	{Name: "name", Key: "name"},
+	{Name: "description", Key: "description"},
+	{Name: "color", Key: "color"},
+	{Name: "graph_data", Key: "graphData", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+		{Name: "box_selection_enabled", Key: "boxSelectionEnabled"},
+		{Name: "data", Key: "data", Kind: hclAttributeKindJSON},
+		{Name: "max_zoom", Key: "maxZoom"},
+		{Name: "min_zoom", Key: "minZoom"},
+		{Name: "pan", Key: "pan", Kind: hclAttributeKindObject, Nested: flowPositionAttributes},
+		{Name: "panning_enabled", Key: "panningEnabled"},
+		{Name: "renderer", Key: "renderer", Kind: hclAttributeKindJSON},
+		{Name: "user_panning_enabled", Key: "userPanningEnabled"},
+		{Name: "user_zooming_enabled", Key: "userZoomingEnabled"},
+		{Name: "zoom", Key: "zoom"},
+		{Name: "zooming_enabled", Key: "zoomingEnabled"},
+		{Name: "elements", Key: "elements", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+			{Name: "nodes", Key: "nodes", Kind: hclAttributeKindIdMap, Nested: append([]hclAttribute{
+				{Name: "data", Key: "data", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+					{Name: "capability_class", Key: "capabilityClass"},
+					{Name: "capability_name", Key: "capabilityName"},
+					{Name: "connection_id", Key: "connectionId"},
+					{Name: "connector_id", Key: "connectorId"},
+					{Name: "id_unique", Key: "idUnique"},
+					{Name: "label", Key: "label"},
+					{Name: "name", Key: "name"},
+					{Name: "node_type", Key: "nodeType"},
+					{Name: "status", Key: "status"},
+					{Name: "type", Key: "type"},
+					{Name: "outcomes", Key: "outcomes", Kind: hclAttributeKindList, Nested: []hclAttribute{
+						{Name: "id", Key: "id"},
+						{Name: "label", Key: "label"},
+						{Name: "result", Key: "result"},
+					}},
+					{Name: "properties", Key: "properties", Kind: hclAttributeKindJSON},
+				}},
+			}, flowElementAttributes...)},
+			{Name: "edges", Key: "edges", Kind: hclAttributeKindIdMap, Nested: append([]hclAttribute{
+				{Name: "data", Key: "data", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+					{Name: "multi_value_source_id", Key: "multiValueSourceId"},
+					{Name: "source", Key: "source"},
+					{Name: "target", Key: "target"},
+				}},
+			}, flowElementAttributes...)},
+		}},
+	}},
+	{Name: "input_schema", Key: "inputSchema", Kind: hclAttributeKindList, Nested: []hclAttribute{
+		{Name: "description", Key: "description"},
+		{Name: "is_expanded", Key: "isExpanded"},
+		{Name: "preferred_control_type", Key: "preferredControlType"},
+		{Name: "preferred_data_type", Key: "preferredDataType"},
+		{Name: "property_name", Key: "propertyName"},
+		{Name: "required", Key: "required"},
+	}},
+	{Name: "output_schema", Key: "outputSchema", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+		{Name: "output", Key: "output", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+			{Name: "type", Key: "type"},
+			{Name: "additional_properties", Key: "additionalProperties"},
+			{Name: "properties", Key: "properties", Kind: hclAttributeKindJSON},
+		}},
+	}},
+	{Name: "settings", Key: "settings", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+		{Name: "csp", Key: "csp"},
+		{Name: "css", Key: "css"},
+		{Name: "css_links", Key: "cssLinks", Kind: hclAttributeKindList},
+		{Name: "custom_error_screen_brand_logo_url", Key: "customErrorScreenBrandLogoUrl"},
+		{Name: "custom_error_show_footer", Key: "customErrorShowFooter"},
+		{Name: "custom_favicon_link", Key: "customFaviconLink"},
+		{Name: "custom_logo_urlselection", Key: "customLogoURLSelection"},
+		{Name: "custom_title", Key: "customTitle"},
+		{Name: "default_error_screen_brand_logo", Key: "defaultErrorScreenBrandLogo"},
+		{Name: "flow_http_timeout_in_seconds", Key: "flowHttpTimeoutInSeconds"},
+		{Name: "flow_timeout_in_seconds", Key: "flowTimeoutInSeconds"},
+		{Name: "intermediate_loading_screen_css", Key: "intermediateLoadingScreenCSS"},
+		{Name: "intermediate_loading_screen_html", Key: "intermediateLoadingScreenHTML"},
+		{Name: "js_custom_flow_player", Key: "jsCustomFlowPlayer"},
+		{Name: "log_level", Key: "logLevel"},
+		{Name: "preview_form_rendering_updates", Key: "previewFormRenderingUpdates"},
+		{Name: "require_authentication_to_initiate", Key: "requireAuthenticationToInitiate"},
+		{Name: "scrub_sensitive_info", Key: "scrubSensitiveInfo"},
+		{Name: "sensitive_info_fields", Key: "sensitiveInfoFields", Kind: hclAttributeKindList},
+		{Name: "use_csp", Key: "useCSP"},
+		{Name: "use_custom_css", Key: "useCustomCSS"},
+		{Name: "use_custom_flow_player", Key: "useCustomFlowPlayer"},
+		{Name: "use_custom_script", Key: "useCustomScript"},
+		{Name: "use_intermediate_loading_screen", Key: "useIntermediateLoadingScreen"},
+		{Name: "validate_on_save", Key: "validateOnSave"},
+		{Name: "js_links", Key: "jsLinks", Kind: hclAttributeKindList, Nested: []hclAttribute{
+			{Name: "crossorigin", Key: "crossorigin"},
+			{Name: "defer", Key: "defer"},
+			{Name: "integrity", Key: "integrity"},
+			{Name: "label", Key: "label"},
+			{Name: "referrerpolicy", Key: "referrerpolicy"},
+			{Name: "type", Key: "type"},
+			{Name: "value", Key: "value"},
+		}},
+	}},
+	{Name: "trigger", Key: "trigger", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+		{Name: "type", Key: "type"},
+		{Name: "subtype", Key: "subtype"},
+		{Name: "configuration", Key: "configuration", Kind: hclAttributeKindObject, Nested: []hclAttribute{
+			{Name: "mfa", Key: "mfa", Kind: hclAttributeKindObject, Nested: flowTriggerConfigurationAttributes},
+			{Name: "pwd", Key: "pwd", Kind: hclAttributeKindObject, Nested: flowTriggerConfigurationAttributes},
+		}},
+	}},
+}
+
+var flowTriggerConfigurationAttributes = []hclAttribute{
+	{Name: "enabled", Key: "enabled"},
+	{Name: "time", Key: "time"},
+	{Name: "time_format", Key: "timeFormat"},
+}
+
+// GenerateFlow writes the HCL for the flows, subflows, variables and connector instances of a DaVinci flow export
+// into the output directory, with the resources referencing each other rather than the IDs of the exporting environment
+func GenerateFlow(input []byte, outputDirectory string, overwrite bool) error {
+	export, err := readFlowExport(input)
+	if err != nil {
+		return err
+	}
+
+	// The parent flow is followed by any subflows included in the export
+	exportedFlows := []map[string]any{export}
+	if embedded, ok := export["_embedded"].([]any); ok {
+		for _, v := range embedded {
+			if subflow, ok := v.(map[string]any); ok {
+				exportedFlows = append(exportedFlows, subflow)
+			}
+		}
+	}
+
+	refs := hclReferences{}
+	usedResourceNames := map[string]bool{}
+
+	// Flows
+	flows := make([]flowFlowData, 0, len(exportedFlows))
+	for _, exportedFlow := range exportedFlows {
+		flowObject, _ := exportedFlow["flow"].(map[string]any)
+		id, _ := flowObject["id"].(string)
+		name, _ := flowObject["name"].(string)
+
+		flow := flowFlowData{
+			Id:           id,
+			ResourceName: uniqueResourceName(usedResourceNames, "flow", name),
+		}
+		if id != "" {
+			refs[id] = fmt.Sprintf("pingone_davinci_flow.%s.id", flow.ResourceName)
+		}
+
+		flows = append(flows, flow)
+	}
+
+	// Connector instances, taken from the connection nodes of each flow
+	connectorInstances := make([]flowConnectorInstanceData, 0)
+	usedResourceNames = map[string]bool{}
+	for _, exportedFlow := range exportedFlows {
+		for _, node := range flowExportNodes(exportedFlow) {
+			data, _ := node["data"].(map[string]any)
+			connectionId, _ := data["connectionId"].(string)
+			connectorId, _ := data["connectorId"].(string)
+			if connectionId == "" || connectorId == "" {
+				continue
+			}
+
+			if _, ok := refs[connectionId]; ok {
+				continue
+			}
+
+			name, _ := data["name"].(string)
+			if name == "" {
+				name = connectorId
+			}
+
+			connectorInstance := flowConnectorInstanceData{
+				Id:           connectionId,
+				ResourceName: uniqueResourceName(usedResourceNames, "connector", connectorId),
+				ConnectorId:  hclQuote(connectorId),
+				Name:         hclQuote(name),
+			}
+			refs[connectionId] = fmt.Sprintf("pingone_davinci_connector_instance.%s.id", connectorInstance.ResourceName)
+
+			connectorInstances = append(connectorInstances, connectorInstance)
+		}
+	}
+
+	// Variables
+	variables := make([]flowVariableData, 0)
+	inputVariables := make([]flowInputVariableData, 0)
+	usedResourceNames = map[string]bool{}
+	seenVariables := map[string]bool{}
+	for _, exportedFlow := range exportedFlows {
+		exportedVariables, _ := exportedFlow["variables"].([]any)
+		for _, v := range exportedVariables {
+			exportedVariable, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			// Company and user variables can be included with each of the exported flows
+			variableFlow, _ := exportedVariable["flow"].(map[string]any)
+			variableKey := fmt.Sprintf("%v/%v/%v", exportedVariable["context"], exportedVariable["name"], variableFlow["id"])
+			if seenVariables[variableKey] {
+				continue
+			}
+			seenVariables[variableKey] = true
+
+			variable, inputVariable := buildFlowVariable(usedResourceNames, exportedVariable, refs)
+			if variable == nil {
+				continue
+			}
+
+			variables = append(variables, *variable)
+			if inputVariable != nil {
+				inputVariables = append(inputVariables, *inputVariable)
+			}
+		}
+	}
+
+	// Flows are rendered once all references are known, and depend on the variables that are not scoped to a flow
+	dependsOn := make([]string, 0)
+	for _, variable := range variables {
+		if variable.Context != "flow" {
+			dependsOn = append(dependsOn, fmt.Sprintf("pingone_davinci_variable.%s", variable.ResourceName))
+		}
+	}
+
+	for i, exportedFlow := range exportedFlows {
+		flowRefs := hclReferences{}
+		for k, v := range refs {
+			// A flow cannot reference its own ID
+			if k != flows[i].Id {
+				flowRefs[k] = v
+			}
+		}
+
+		flowValues := make(map[string]any, len(exportedFlow))
+		for k, v := range exportedFlow {
+			flowValues[k] = v
+		}
+		if flowObject, ok := exportedFlow["flow"].(map[string]any); ok {
+			flowValues["name"] = flowObject["name"]
+		}
+
+		flows[i].Body = renderHCLBody(flowAttributes, flowValues, 1, flowRefs)
+		flows[i].DependsOn = dependsOn
+	}
+
+	// Subflows are written before the flows that call them
+	slices.Reverse(flows)
+
+	if err := os.MkdirAll(outputDirectory, 0755); err != nil {
+		return err
+	}
+
+	if len(connectorInstances) > 0 {
+		if err := writeFlowFile(outputDirectory, "davinci_connector_instances.tf", internal.FlowConnectorInstancesTmpl, connectorInstances, overwrite); err != nil {
+			return err
+		}
+	}
+
+	if len(variables) > 0 {
+		if err := writeFlowFile(outputDirectory, "davinci_variables.tf", internal.FlowVariablesTmpl, variables, overwrite); err != nil {
+			return err
+		}
+	}
+
+	if err := writeFlowFile(outputDirectory, "davinci_flows.tf", internal.FlowFlowsTmpl, flows, overwrite); err != nil {
+		return err
+	}
+
+	return writeFlowFile(outputDirectory, "variables.tf", internal.FlowInputVariablesTmpl, inputVariables, overwrite)
+}
+
+func readFlowExport(input []byte) (map[string]any, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("no flow export input provided. Please provide the export via the -file flag")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	// Keep numbers as they are written in the export
+	decoder.UseNumber()
+
+	var export map[string]any
+	if err := decoder.Decode(&export); err != nil {
+		return nil, fmt.Errorf("error parsing the flow export: %w", err)
+	}
+
+	if _, ok := export["flow"].(map[string]any); !ok {
+		return nil, fmt.Errorf("the input is not a DaVinci flow export, the \"flow\" object is missing")
+	}
+
+	return export, nil
+}
+
+func flowExportNodes(exportedFlow map[string]any) []map[string]any {
+	nodes := make([]map[string]any, 0)
+
+	graphData, _ := exportedFlow["graphData"].(map[string]any)
+	elements, _ := graphData["elements"].(map[string]any)
+	exportedNodes, _ := elements["nodes"].([]any)
+	for _, v := range exportedNodes {
+		if node, ok := v.(map[string]any); ok {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+func buildFlowVariable(usedResourceNames map[string]bool, exportedVariable map[string]any, refs hclReferences) (*flowVariableData, *flowInputVariableData) {
+	name, _ := exportedVariable["name"].(string)
+	context, _ := exportedVariable["context"].(string)
+	dataType, _ := exportedVariable["dataType"].(string)
+	if name == "" || context == "" || dataType == "" {
+		return nil, nil
+	}
+
+	variable := flowVariableData{
+		ResourceName: uniqueResourceName(usedResourceNames, "variable", fmt.Sprintf("%s_%s", context, name)),
+		Context:      context,
+	}
+
+	lines := []hclBodyLine{
+		{name: "name", value: hclQuote(name)},
+		{name: "context", value: hclQuote(context)},
+		{name: "data_type", value: hclQuote(dataType)},
+	}
+
+	for _, attribute := range []hclAttribute{
+		{Name: "display_name", Key: "displayName"},
+		{Name: "mutable", Key: "mutable"},
+		{Name: "min", Key: "min"},
+		{Name: "max", Key: "max"},
+	} {
+		if value, ok := renderHCLValue(exportedVariable[attribute.Key], nil); ok {
+			lines = append(lines, hclBodyLine{name: attribute.Name, value: value})
+		}
+	}
+
+	if flowObject, ok := exportedVariable["flow"].(map[string]any); ok {
+		if value, ok := renderHCLValue(flowObject["id"], refs); ok {
+			lines = append(lines, hclBodyLine{name: "flow", value: fmt.Sprintf("{\n    id = %s\n  }", value)})
+		}
+	}
+
+	var inputVariable *flowInputVariableData
+	if value, ok := exportedVariable["value"]; ok && value != nil {
+		var valueAttribute hclBodyLine
+		switch dataType {
+		case "boolean":
+			valueAttribute.name = "bool"
+			valueAttribute.value, ok = renderHCLValue(value, nil)
+		case "number":
+			valueAttribute.name = "float32"
+			valueAttribute.value, ok = renderHCLValue(value, nil)
+		case "object":
+			valueAttribute.name = "json_object"
+			valueAttribute.value = fmt.Sprintf("jsonencode(%s)", renderHCLExpression(value, 2, nil))
+		case "secret":
+			// Secret values are not exported in the clear, so are sourced from a sensitive input variable
+			inputVariable = &flowInputVariableData{
+				Name:        fmt.Sprintf("%s_value", variable.ResourceName),
+				Description: hclQuote(fmt.Sprintf("The value of the %s DaVinci variable %q.", context, name)),
+			}
+			valueAttribute.name = "secret_string"
+			valueAttribute.value = fmt.Sprintf("var.%s", inputVariable.Name)
+		default:
+			valueAttribute.name = "string"
+			valueAttribute.value, ok = renderHCLValue(value, nil)
+		}
+
+		if ok {
+			lines = append(lines, hclBodyLine{name: "value", value: fmt.Sprintf("{\n    %s = %s\n  }", valueAttribute.name, valueAttribute.value)})
+		}
+	}
+
+	variable.Body = renderHCLBodyLines(lines, 1, true)
+
+	return &variable, inputVariable
+}
+
+var repeatedUnderscores = regexp.MustCompile(`_+`)
+
+// uniqueResourceName converts the name into a Terraform resource name that hasn't already been used
+func uniqueResourceName(usedResourceNames map[string]bool, prefix, name string) string {
+	resourceName := strings.Trim(repeatedUnderscores.ReplaceAllString(camelToSnake(name), "_"), "_")
+	if resourceName == "" || !unicode.IsLetter(rune(resourceName[0])) {
+		resourceName = strings.Trim(fmt.Sprintf("%s_%s", prefix, resourceName), "_")
+	}
+
+	candidate := resourceName
+	for i := 2; usedResourceNames[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", resourceName, i)
+	}
+	usedResourceNames[candidate] = true
+
+	return candidate
+}
+
+func writeFlowFile(outputDirectory, fileName, templateString string, data any, overwrite bool) error {
+	t, err := template.New(fileName).Parse(templateString)
+	if err != nil {
+		return err
+	}
+
+	return writeTemplateFile(t, filepath.Join(outputDirectory, fileName), overwrite, data)
+}
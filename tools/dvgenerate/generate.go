@@ -55,6 +55,7 @@ func Generate(input []byte) {
 
 	GenerateReferenceTemplate(baseDirectory, conns)
 	GenerateConnectorHCLExamples(baseDirectory, conns)
+	GenerateTypedConnectorResources(baseDirectory, conns)
 }
 
 func GenerateReferenceTemplate(baseDirectory string, conns []connectorDocData) {
@@ -0,0 +1,285 @@
+package dvgenerate
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type hclAttributeKind int
+
+const (
+	// A string, number or boolean value
+	hclAttributeKindValue hclAttributeKind = iota
+	// An arbitrary JSON value, rendered with jsonencode()
+	hclAttributeKindJSON
+	// A nested object
+	hclAttributeKindObject
+	// A list (or set) of values, or of nested objects where nested attributes are defined
+	hclAttributeKindList
+	// A map of nested objects, built from an export array and keyed by each element's data.id value
+	hclAttributeKindIdMap
+)
+
+// hclAttribute maps an export JSON key onto a resource attribute
+type hclAttribute struct {
+	Name   string
+	Key    string
+	Kind   hclAttributeKind
+	Nested []hclAttribute
+}
+
+// hclReferences maps literal IDs found in the export to the HCL expressions that should replace them
+type hclReferences map[string]string
+
+type hclBodyLine struct {
+	name  string
+	value string
+}
+
+// renderHCLBody renders the attributes present in the input object, one per line, at the given indent level.
+// Consecutive single line attributes have their equals signs aligned as `terraform fmt` would.
+func renderHCLBody(attributes []hclAttribute, input map[string]any, indent int, refs hclReferences) string {
+	lines := make([]hclBodyLine, 0, len(attributes))
+	for _, attribute := range attributes {
+		v, ok := input[attribute.Key]
+		if !ok || v == nil {
+			continue
+		}
+
+		value, ok := renderHCLAttributeValue(attribute, v, indent, refs)
+		if !ok {
+			continue
+		}
+
+		lines = append(lines, hclBodyLine{name: attribute.Name, value: value})
+	}
+
+	return renderHCLBodyLines(lines, indent, true)
+}
+
+func renderHCLBodyLines(lines []hclBodyLine, indent int, separateGroups bool) string {
+	var b strings.Builder
+	pad := strings.Repeat("  ", indent)
+
+	for i := 0; i < len(lines); {
+		// Groups of attributes are separated with a blank line
+		if i > 0 && separateGroups {
+			b.WriteString("\n")
+		}
+
+		// Multi line attributes are a group of their own
+		if strings.Contains(lines[i].value, "\n") {
+			fmt.Fprintf(&b, "%s%s = %s\n", pad, lines[i].name, lines[i].value)
+			i++
+			continue
+		}
+
+		// Otherwise the group is the run of single line attributes starting at i
+		j := i
+		width := 0
+		for j < len(lines) && !strings.Contains(lines[j].value, "\n") {
+			width = max(width, len(lines[j].name))
+			j++
+		}
+
+		for ; i < j; i++ {
+			fmt.Fprintf(&b, "%s%-*s = %s\n", pad, width, lines[i].name, lines[i].value)
+		}
+	}
+
+	return b.String()
+}
+
+func renderHCLAttributeValue(attribute hclAttribute, v any, indent int, refs hclReferences) (string, bool) {
+	pad := strings.Repeat("  ", indent)
+
+	switch attribute.Kind {
+	case hclAttributeKindValue:
+		return renderHCLValue(v, refs)
+
+	case hclAttributeKindJSON:
+		return fmt.Sprintf("jsonencode(%s)", renderHCLExpression(v, indent, refs)), true
+
+	case hclAttributeKindObject:
+		object, ok := v.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("{\n%s%s}", renderHCLBody(attribute.Nested, object, indent+1, refs), pad), true
+
+	case hclAttributeKindList:
+		list, ok := v.([]any)
+		if !ok {
+			return "", false
+		}
+		if len(list) == 0 {
+			return "[]", true
+		}
+
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, element := range list {
+			if attribute.Nested == nil {
+				value, ok := renderHCLValue(element, refs)
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(&b, "%s  %s,\n", pad, value)
+				continue
+			}
+
+			object, ok := element.(map[string]any)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&b, "%s  {\n%s%s  },\n", pad, renderHCLBody(attribute.Nested, object, indent+2, refs), pad)
+		}
+		fmt.Fprintf(&b, "%s]", pad)
+		return b.String(), true
+
+	case hclAttributeKindIdMap:
+		list, ok := v.([]any)
+		if !ok {
+			return "", false
+		}
+
+		elements := make(map[string]map[string]any, len(list))
+		for _, element := range list {
+			object, ok := element.(map[string]any)
+			if !ok {
+				continue
+			}
+			data, ok := object["data"].(map[string]any)
+			if !ok {
+				continue
+			}
+			id, ok := data["id"].(string)
+			if !ok || id == "" {
+				continue
+			}
+			elements[id] = object
+		}
+
+		if len(elements) == 0 {
+			return "{}", true
+		}
+
+		ids := make([]string, 0, len(elements))
+		for id := range elements {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+
+		var b strings.Builder
+		b.WriteString("{\n")
+		for i, id := range ids {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "%s  %s = {\n%s%s  }\n", pad, hclQuote(id), renderHCLBody(attribute.Nested, elements[id], indent+2, refs), pad)
+		}
+		fmt.Fprintf(&b, "%s}", pad)
+		return b.String(), true
+	}
+
+	return "", false
+}
+
+// renderHCLValue renders a primitive value, replacing string IDs with their reference where one is known
+func renderHCLValue(v any, refs hclReferences) (string, bool) {
+	switch t := v.(type) {
+	case string:
+		if ref, ok := refs[t]; ok {
+			return ref, true
+		}
+		return hclQuote(t), true
+	case json.Number:
+		return t.String(), true
+	case bool:
+		return fmt.Sprintf("%t", t), true
+	}
+
+	return "", false
+}
+
+// renderHCLExpression renders any JSON value as an HCL expression, for use in jsonencode()
+func renderHCLExpression(v any, indent int, refs hclReferences) string {
+	pad := strings.Repeat("  ", indent)
+
+	switch t := v.(type) {
+	case nil:
+		return "null"
+
+	case map[string]any:
+		if len(t) == 0 {
+			return "{}"
+		}
+
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		lines := make([]hclBodyLine, 0, len(keys))
+		for _, k := range keys {
+			lines = append(lines, hclBodyLine{name: hclQuote(k), value: renderHCLExpression(t[k], indent+1, refs)})
+		}
+		return fmt.Sprintf("{\n%s%s}", renderHCLBodyLines(lines, indent+1, false), pad)
+
+	case []any:
+		if len(t) == 0 {
+			return "[]"
+		}
+
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, element := range t {
+			fmt.Fprintf(&b, "%s  %s,\n", pad, renderHCLExpression(element, indent+1, refs))
+		}
+		fmt.Fprintf(&b, "%s]", pad)
+		return b.String()
+	}
+
+	value, ok := renderHCLValue(v, refs)
+	if !ok {
+		return "null"
+	}
+
+	return value
+}
+
+// hclQuote returns the input as a quoted HCL string, escaping template sequences so that the value is kept literally
+func hclQuote(s string) string {
+	var b strings.Builder
+	b.WriteString(`"`)
+	for i, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			b.WriteRune(r)
+			if i+1 < len(s) && s[i+1] == '{' {
+				b.WriteRune(r)
+			}
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteString(`"`)
+	return b.String()
+}
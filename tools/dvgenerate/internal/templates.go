@@ -7,3 +7,27 @@ var ConnectorTmpl string
 
 //go:embed templates/connector_reference.tmpl
 var ConnectorReferenceTmpl string
+
+//go:embed templates/flow_connector_instances.tmpl
+var FlowConnectorInstancesTmpl string
+
+//go:embed templates/flow_variables.tmpl
+var FlowVariablesTmpl string
+
+//go:embed templates/flow_flows.tmpl
+var FlowFlowsTmpl string
+
+//go:embed templates/flow_input_variables.tmpl
+var FlowInputVariablesTmpl string
+
+//go:embed templates/typed_connector_resource.tmpl
+var TypedConnectorResourceTmpl string
+
+//go:embed templates/typed_connector_example.tmpl
+var TypedConnectorExampleTmpl string
+
+//go:embed templates/typed_connector_import.tmpl
+var TypedConnectorImportTmpl string
+
+//go:embed templates/typed_connector_doc.tmpl
+var TypedConnectorDocTmpl string
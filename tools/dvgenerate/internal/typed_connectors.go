@@ -0,0 +1,54 @@
+package internal
+
+type TypedConnector struct {
+	// The suffix of the resource name, for example "http" for the pingone_davinci_connector_instance_http resource
+	ResourceSuffix string
+	// The connection properties of the connector to expose as resource attributes
+	Properties []string
+	// Properties that hold secrets, in addition to those marked as secure in the connector schema
+	SensitiveProperties []string
+}
+
+var (
+	// TypedConnectors defines the connectors that have a typed connector instance resource, keyed by connector ID
+	TypedConnectors = map[string]TypedConnector{
+		"httpConnector": {
+			ResourceSuffix: "http",
+			Properties: []string{
+				"connectionId",
+				"recaptchaSecretKey",
+				"recaptchaSiteKey",
+			},
+			SensitiveProperties: []string{
+				"recaptchaSecretKey",
+			},
+		},
+
+		"pingOneMfaConnector": {
+			ResourceSuffix: "pingone_mfa",
+			Properties: []string{
+				"clientId",
+				"clientSecret",
+				"envId",
+				"policyId",
+				"region",
+			},
+			SensitiveProperties: []string{
+				"clientSecret",
+			},
+		},
+
+		"pingOneSSOConnector": {
+			ResourceSuffix: "pingone_sso",
+			Properties: []string{
+				"clientId",
+				"clientSecret",
+				"envId",
+				"region",
+			},
+			SensitiveProperties: []string{
+				"clientSecret",
+			},
+		},
+	}
+)
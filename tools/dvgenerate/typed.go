@@ -0,0 +1,207 @@
+package dvgenerate
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/pingidentity/terraform-provider-pingone/dvgenerate/internal"
+)
+
+type typedConnectorData struct {
+	ConnectorName string
+	ConnectorId   string
+	TypeName      string
+	GoName        string
+	Properties    []typedConnectorPropertyData
+}
+
+type typedConnectorPropertyData struct {
+	Attribute     string
+	Property      string
+	GoType        string
+	Description   string
+	Required      bool
+	Sensitive     bool
+	AllowedValues []string
+	ExampleValue  string
+	// The attribute name padded to align the example values, as formatted by terraform fmt
+	ExampleAttribute string
+}
+
+// GenerateTypedConnectorResources generates the typed connector instance resources, with their examples and
+// documentation templates, for the connectors in internal.TypedConnectors that are found in the connector schema
+func GenerateTypedConnectorResources(baseDirectory string, conns []connectorDocData) {
+
+	for _, conn := range conns {
+		typedConnector, ok := internal.TypedConnectors[conn.ConnectorId]
+		if !ok {
+			continue
+		}
+
+		data, err := newTypedConnectorData(conn, typedConnector)
+		if err != nil {
+			panic(err)
+		}
+
+		resourceName := fmt.Sprintf("pingone_%s", data.TypeName)
+
+		err = writeTypedConnectorResource(fmt.Sprintf("%s/internal/service/davinci/resource_%s_gen.go", baseDirectory, data.TypeName), data)
+		if err != nil {
+			panic(err)
+		}
+
+		exampleDirectory := fmt.Sprintf("%s/examples/resources/%s", baseDirectory, resourceName)
+		if err := os.MkdirAll(exampleDirectory, 0755); err != nil {
+			panic(err)
+		}
+
+		err = writeTypedConnectorTemplate(fmt.Sprintf("%s/resource.tf", exampleDirectory), internal.TypedConnectorExampleTmpl, "{{", "}}", data)
+		if err != nil {
+			panic(err)
+		}
+
+		err = writeTypedConnectorTemplate(fmt.Sprintf("%s/import.sh", exampleDirectory), internal.TypedConnectorImportTmpl, "{{", "}}", data)
+		if err != nil {
+			panic(err)
+		}
+
+		// The documentation template is itself a template, so is generated with different delimiters
+		err = writeTypedConnectorTemplate(fmt.Sprintf("%s/templates/resources/%s.md.tmpl", baseDirectory, data.TypeName), internal.TypedConnectorDocTmpl, "[[", "]]", data)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func newTypedConnectorData(conn connectorDocData, typedConnector internal.TypedConnector) (typedConnectorData, error) {
+	data := typedConnectorData{
+		ConnectorName: conn.ConnectorName,
+		ConnectorId:   conn.ConnectorId,
+		TypeName:      fmt.Sprintf("davinci_connector_instance_%s", typedConnector.ResourceSuffix),
+		GoName:        snakeToPascal(typedConnector.ResourceSuffix),
+	}
+
+	for _, propertyName := range typedConnector.Properties {
+		propertyMap, ok := conn.RawProperties[propertyName].(map[string]any)
+		if !ok {
+			return data, fmt.Errorf("property %s of typed connector %s is not defined in the connector schema", propertyName, conn.ConnectorId)
+		}
+
+		property := typedConnectorPropertyData{
+			Attribute: camelToSnake(propertyName),
+			Property:  propertyName,
+			GoType:    "davinciTypedConnectorPropertyTypeString",
+		}
+
+		propertyType := "string"
+		if v, ok := propertyMap["type"].(string); ok && v != "" {
+			propertyType = v
+		}
+
+		switch rewritePropertyType(propertyType) {
+		case "boolean":
+			property.GoType = "davinciTypedConnectorPropertyTypeBool"
+		case "number":
+			property.GoType = "davinciTypedConnectorPropertyTypeNumber"
+		case "json":
+			property.GoType = "davinciTypedConnectorPropertyTypeJSON"
+		}
+
+		if v, ok := propertyMap["info"].(string); ok && strings.TrimSpace(v) != "" {
+			property.Description = strings.TrimSpace(v)
+		} else if v, ok := propertyMap["displayName"].(string); ok && strings.TrimSpace(v) != "" {
+			property.Description = strings.TrimSpace(v)
+		}
+
+		if property.Description != "" && !strings.HasSuffix(property.Description, ".") {
+			property.Description = fmt.Sprintf("%s.", property.Description)
+		}
+
+		if v, ok := propertyMap["required"].(bool); ok && v {
+			property.Required = true
+		}
+
+		if v, ok := propertyMap["secure"].(bool); ok && v {
+			property.Sensitive = true
+		}
+
+		if slices.Contains(typedConnector.SensitiveProperties, propertyName) {
+			property.Sensitive = true
+		}
+
+		if options, ok := propertyMap["options"].([]any); ok && property.GoType == "davinciTypedConnectorPropertyTypeString" {
+			for _, option := range options {
+				if optionMap, ok := option.(map[string]any); ok {
+					if v, ok := optionMap["value"].(string); ok {
+						property.AllowedValues = append(property.AllowedValues, v)
+					}
+				}
+			}
+		}
+
+		if v, ok := internal.ExampleValues[conn.ConnectorId][propertyName]; ok {
+			property.ExampleValue = v.Value
+		} else {
+			property.ExampleValue = fmt.Sprintf("var.%s_property_%s", strings.ToLower(conn.ConnectorId), camelToSnake(propertyName))
+		}
+
+		data.Properties = append(data.Properties, property)
+	}
+
+	attributeWidth := 0
+	for _, property := range data.Properties {
+		attributeWidth = max(attributeWidth, len(property.Attribute))
+	}
+	for i := range data.Properties {
+		data.Properties[i].ExampleAttribute = fmt.Sprintf("%-*s", attributeWidth, data.Properties[i].Attribute)
+	}
+
+	return data, nil
+}
+
+func writeTypedConnectorResource(fileName string, data typedConnectorData) error {
+	t, err := template.New(fmt.Sprintf("TypedConnectorResource-%s", data.ConnectorId)).Parse(internal.TypedConnectorResourceTmpl)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated resource %s: %w", fileName, err)
+	}
+
+	return os.WriteFile(filepath.Clean(fileName), source, 0644)
+}
+
+func writeTypedConnectorTemplate(fileName, templateString, leftDelim, rightDelim string, data typedConnectorData) error {
+	t, err := template.New(fmt.Sprintf("TypedConnector-%s-%s", data.ConnectorId, filepath.Base(fileName))).Delims(leftDelim, rightDelim).Parse(templateString)
+	if err != nil {
+		return err
+	}
+
+	return writeTemplateFile(t, fileName, true, data)
+}
+
+func snakeToPascal(snake string) string {
+	var buf strings.Builder
+	for _, part := range strings.Split(snake, "_") {
+		if part == "" {
+			continue
+		}
+		buf.WriteString(strings.ToUpper(part[:1]))
+		buf.WriteString(part[1:])
+	}
+
+	return buf.String()
+}